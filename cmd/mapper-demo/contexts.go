@@ -0,0 +1,161 @@
+// Package main multi-cluster mapping across kubeconfig contexts
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+
+	"golang.org/x/sync/errgroup"
+	"sigs.k8s.io/yaml"
+
+	"github.com/fluid-cloudnative/fluid-resource-mapper/pkg/k8s"
+	"github.com/fluid-cloudnative/fluid-resource-mapper/pkg/mapper"
+	"github.com/fluid-cloudnative/fluid-resource-mapper/pkg/types"
+)
+
+// clusterResult is the outcome of mapping the dataset through one context
+type clusterResult struct {
+	context string
+	graph   *types.ResourceGraph
+	err     error
+}
+
+// mapAcrossContexts maps the dataset through every --contexts entry at once
+// and renders the graphs keyed by cluster name. JSON and YAML print a single
+// cluster → ResourceGraph map; the other formats render each cluster in turn
+// under a header. The exit code is the highest of the per-cluster codes.
+func mapAcrossContexts(name string, contexts []string, opts mapper.Options) {
+	if *watch || *mockMode || *snapshotDir != "" || *kubeContext != "" {
+		fmt.Fprintln(os.Stderr, "❌ --contexts cannot be used with --watch, --mock, --snapshot or --context")
+		exit(1)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), *timeout)
+	defer cancel()
+
+	results := make([]clusterResult, len(contexts))
+	var g errgroup.Group
+	for i, kubeCtx := range contexts {
+		i, kubeCtx := i, kubeCtx
+		g.Go(func() error {
+			results[i] = mapInContext(ctx, name, kubeCtx, opts)
+			return nil
+		})
+	}
+	_ = g.Wait()
+
+	graphs := make(map[string]*types.ResourceGraph)
+	code := exitOK
+	for _, r := range results {
+		if r.err != nil {
+			fmt.Fprintf(os.Stderr, "❌ Mapping failed in context %s: %v\n", r.context, r.err)
+			code = max(code, exitCodeFor(r.err))
+		}
+		if r.graph == nil {
+			continue
+		}
+		if !r.graph.IsHealthy() {
+			code = max(code, exitUnhealthy)
+		}
+
+		key := r.graph.Metadata.ClusterName
+		if _, taken := graphs[key]; taken || key == "" {
+			// Contexts pointing at the same cluster stay apart
+			key = r.context
+		}
+		graphs[key] = filterGraph(r.graph)
+		sendAlert(r.graph)
+	}
+
+	if err := renderGraphs(graphs, writeClusterHeader); err != nil {
+		renderFailed(err)
+		exit(1)
+	}
+	exit(code)
+}
+
+// mapInContext builds a client for the kubeconfig context and maps the dataset with it
+func mapInContext(ctx context.Context, name, kubeCtx string, opts mapper.Options) clusterResult {
+	client, err := k8s.NewClient(k8s.ClientConfig{
+		KubeconfigPath:        *kubeconfig,
+		Context:               kubeCtx,
+		Logger:                newLogger(),
+		QPS:                   float32(*qps),
+		Burst:                 *burst,
+		MaxConcurrentRequests: *maxRequests,
+	})
+	if err != nil {
+		return clusterResult{context: kubeCtx, err: fmt.Errorf("%w: %v", mapper.ErrClusterUnreachable, err)}
+	}
+
+	m := mapper.New(client).WithLogger(newLogger())
+	graph, err := m.MapFromDataset(ctx, name, targetNamespace(), opts)
+	return clusterResult{context: kubeCtx, graph: graph, err: err}
+}
+
+// renderGraphs writes keyed graphs in the -o format: JSON and YAML as a
+// single map, the other formats one graph after another in key order, each
+// under the header written by writeHeader
+func renderGraphs(graphs map[string]*types.ResourceGraph, writeHeader func(w io.Writer, key string, gap bool)) error {
+	switch {
+	case *outputFormat == "json" && !*onlyWarnings && !*explainMode:
+		data, err := json.MarshalIndent(graphs, "", "  ")
+		if err != nil {
+			return fmt.Errorf("failed to marshal JSON: %w", err)
+		}
+		_, err = fmt.Fprintln(stdout, string(data))
+		return err
+	case *outputFormat == "yaml" && !*onlyWarnings && !*explainMode:
+		data, err := yaml.Marshal(graphs)
+		if err != nil {
+			return fmt.Errorf("failed to marshal YAML: %w", err)
+		}
+		_, err = stdout.Write(data)
+		return err
+	}
+
+	renderer := renderers[*outputFormat]
+	out := stdout
+	if humanFormats[*outputFormat] || (*onlyWarnings && *outputFormat != "json") {
+		out = outputWriter()
+	}
+	if *onlyWarnings {
+		renderer = RendererFunc(outputWarnings)
+	}
+	if *explainMode {
+		out = stdout
+		renderer = RendererFunc(outputExplain)
+	}
+
+	for i, key := range sortedKeys(graphs) {
+		if humanFormats[*outputFormat] || *onlyWarnings || *explainMode {
+			writeHeader(out, key, i > 0)
+		}
+		if err := renderer.Render(out, graphs[key]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// sortedKeys returns the keys of graphs in order
+func sortedKeys(graphs map[string]*types.ResourceGraph) []string {
+	keys := make([]string, 0, len(graphs))
+	for key := range graphs {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// writeClusterHeader separates the clusters in the human-readable formats
+func writeClusterHeader(w io.Writer, cluster string, gap bool) {
+	if gap {
+		fmt.Fprintln(w)
+	}
+	fmt.Fprintf(w, "🌐 Cluster: %s\n", cluster)
+}
@@ -0,0 +1,91 @@
+// Package main diff subcommand
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/fluid-cloudnative/fluid-resource-mapper/pkg/diff"
+	"github.com/fluid-cloudnative/fluid-resource-mapper/pkg/types"
+)
+
+// diffGraphs compares two graphs saved with -o json and prints the differences
+func diffGraphs(oldPath, newPath string) {
+	if oldPath == "" || newPath == "" {
+		fmt.Fprintln(os.Stderr, "❌ diff requires two files: mapper-demo diff <old.json> <new.json>")
+		exit(1)
+	}
+
+	oldGraph, err := loadGraph(oldPath)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "❌ %v\n", err)
+		exit(1)
+	}
+	newGraph, err := loadGraph(newPath)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "❌ %v\n", err)
+		exit(1)
+	}
+
+	d := diff.Diff(oldGraph, newGraph)
+	if *outputFormat == "json" {
+		encoder := json.NewEncoder(stdout)
+		encoder.SetIndent("", "  ")
+		err = encoder.Encode(d)
+	} else {
+		err = outputDiff(outputWriter(), d)
+	}
+	if err != nil {
+		renderFailed(err)
+		exit(1)
+	}
+}
+
+// loadGraph reads a ResourceGraph written by the JSON renderer
+func loadGraph(path string) (*types.ResourceGraph, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", path, err)
+	}
+
+	var graph types.ResourceGraph
+	if err := json.Unmarshal(data, &graph); err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", path, err)
+	}
+	return &graph, nil
+}
+
+// outputDiff prints a human-readable summary of a graph diff
+func outputDiff(w io.Writer, d *diff.GraphDiff) error {
+	if d.IsEmpty() {
+		_, err := fmt.Fprintln(w, "✓ No differences")
+		return err
+	}
+
+	for _, r := range d.Added {
+		fmt.Fprintf(w, "+ %s %s: %s\n", r.Name, r.Kind, r.Phase)
+	}
+	for _, r := range d.Removed {
+		fmt.Fprintf(w, "- %s %s: %s\n", r.Name, r.Kind, r.Phase)
+	}
+	for _, c := range d.Changed {
+		line := fmt.Sprintf("~ %s %s: %s → %s", c.Name, c.Kind, c.OldPhase, c.NewPhase)
+		if c.OldReady != c.NewReady {
+			line += fmt.Sprintf(" (%s → %s)", c.OldReady, c.NewReady)
+		}
+		fmt.Fprintln(w, line)
+	}
+
+	for _, warning := range d.NewWarnings {
+		fmt.Fprintf(w, "%s %s: new - %s\n", warning.Level.StatusIcon(), warning.Code, warning.Message)
+	}
+	for _, warning := range d.ResolvedWarnings {
+		fmt.Fprintf(w, "✅ %s: resolved\n", warning.Code)
+	}
+
+	_, err := fmt.Fprintf(w, "\n%d added, %d removed, %d changed, %d new warnings, %d resolved warnings\n",
+		len(d.Added), len(d.Removed), len(d.Changed), len(d.NewWarnings), len(d.ResolvedWarnings))
+	return err
+}
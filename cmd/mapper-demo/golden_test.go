@@ -0,0 +1,104 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"flag"
+	"os"
+	"path/filepath"
+	"regexp"
+	"testing"
+
+	"github.com/fluid-cloudnative/fluid-resource-mapper/pkg/k8s"
+	"github.com/fluid-cloudnative/fluid-resource-mapper/pkg/mapper"
+)
+
+var update = flag.Bool("update", false, "rewrite the golden files in testdata/golden")
+
+// goldenScenarios are the mock scenarios rendered by TestGoldenOutput
+var goldenScenarios = []k8s.MockScenario{
+	k8s.ScenarioHealthy,
+	k8s.ScenarioPartialReady,
+	k8s.ScenarioMissingRuntime,
+	k8s.ScenarioMissingFuse,
+	k8s.ScenarioFailedPods,
+	k8s.ScenarioOrphaned,
+	k8s.ScenarioMultipleDatasets,
+	k8s.ScenarioStaleRevision,
+	k8s.ScenarioScaling,
+	k8s.ScenarioNoEndpoints,
+	k8s.ScenarioSidecarFuse,
+	k8s.ScenarioAppLabelOnly,
+	k8s.ScenarioControllerDown,
+	k8s.ScenarioCrossNamespace,
+	k8s.ScenarioTerminating,
+	k8s.ScenarioDatasetLabel,
+}
+
+// goldenFormats are the -o formats compared against golden files
+var goldenFormats = []string{"tree", "json", "wide"}
+
+// volatile matches output that depends on when the mapping ran
+var volatile = []struct {
+	pattern     *regexp.Regexp
+	replacement string
+}{
+	{regexp.MustCompile(`\d{4}-\d{2}-\d{2}T\d{2}:\d{2}:\d{2}(\.\d+)?(Z|[+-]\d{2}:\d{2})`), "<timestamp>"},
+	{regexp.MustCompile(`\d{4}-\d{2}-\d{2} \d{2}:\d{2}:\d{2}`), "<timestamp>"},
+}
+
+// TestGoldenOutput maps demo-data with every mock scenario and compares the
+// tree, json and wide output with testdata/golden/<scenario>.<format>.
+// Run with -update to rewrite the files after an intended output change.
+func TestGoldenOutput(t *testing.T) {
+	for _, scenario := range goldenScenarios {
+		for _, format := range goldenFormats {
+			t.Run(string(scenario)+"/"+format, func(t *testing.T) {
+				got := renderScenario(t, scenario, format)
+				path := filepath.Join("testdata", "golden", string(scenario)+"."+format)
+
+				if *update {
+					if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+						t.Fatal(err)
+					}
+					if err := os.WriteFile(path, got, 0o644); err != nil {
+						t.Fatal(err)
+					}
+					return
+				}
+
+				want, err := os.ReadFile(path)
+				if err != nil {
+					t.Fatalf("reading golden file (run go test -update to create it): %v", err)
+				}
+				if !bytes.Equal(got, want) {
+					t.Errorf("output differs from %s (run go test -update if the change is intended)\n--- got ---\n%s", path, got)
+				}
+			})
+		}
+	}
+}
+
+// renderScenario maps demo-data with the scenario's mock client and the
+// default flags, and renders it in the format with the mapping duration and
+// timestamps masked
+func renderScenario(t *testing.T, scenario k8s.MockScenario, format string) []byte {
+	t.Helper()
+
+	graph, err := mapper.New(k8s.NewMockClient(scenario)).MapFromDataset(context.Background(), "demo-data", "default", mappingOptions())
+	if graph == nil {
+		t.Fatalf("mapping failed: %v", err)
+	}
+	graph.Metadata.Duration = "1ms"
+
+	var buf bytes.Buffer
+	if err := renderers[format].Render(&buf, filterGraph(graph)); err != nil {
+		t.Fatalf("rendering %s: %v", format, err)
+	}
+
+	out := buf.Bytes()
+	for _, v := range volatile {
+		out = v.pattern.ReplaceAll(out, []byte(v.replacement))
+	}
+	return out
+}
@@ -4,13 +4,26 @@ package main
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"flag"
 	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
 	"os"
+	"os/signal"
 	"strings"
+	"syscall"
+	"text/tabwriter"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 
 	"github.com/fluid-cloudnative/fluid-resource-mapper/pkg/k8s"
 	"github.com/fluid-cloudnative/fluid-resource-mapper/pkg/mapper"
+	"github.com/fluid-cloudnative/fluid-resource-mapper/pkg/metrics"
+	"github.com/fluid-cloudnative/fluid-resource-mapper/pkg/notify"
 	"github.com/fluid-cloudnative/fluid-resource-mapper/pkg/types"
 )
 
@@ -27,9 +40,9 @@ func reorderArgs(args []string) []string {
 			flags = append(flags, arg)
 			// Check if it's a flag with value (not a boolean)
 			if !strings.Contains(arg, "=") && i+1 < len(args) && !strings.HasPrefix(args[i+1], "-") {
-				// Check if it's not one of the known boolean flags
+				// Boolean flags never consume the next argument
 				flagName := strings.TrimLeft(arg, "-")
-				if flagName != "mock" && flagName != "pods" && flagName != "help" && flagName != "version" {
+				if !isBoolFlag(flagName) {
 					i++
 					flags = append(flags, args[i])
 				}
@@ -43,6 +56,16 @@ func reorderArgs(args []string) []string {
 	return append(flags, positional...)
 }
 
+// isBoolFlag reports whether name is a registered boolean flag
+func isBoolFlag(name string) bool {
+	f := flag.Lookup(name)
+	if f == nil {
+		return false
+	}
+	b, ok := f.Value.(interface{ IsBoolFlag() bool })
+	return ok && b.IsBoolFlag()
+}
+
 // Version information
 const (
 	version = "1.0.0"
@@ -54,34 +77,133 @@ const (
 `
 )
 
+// Exit codes returned by the CLI
+const (
+	exitOK              = 0
+	exitUnhealthy       = 1
+	exitClusterError    = 2
+	exitDatasetNotFound = 3
+	exitAccessDenied    = 4
+)
+
 // CLI flags
 var (
-	namespace    = flag.String("n", "default", "Kubernetes namespace")
-	outputFormat = flag.String("o", "tree", "Output format: tree, json, wide")
-	mockMode     = flag.Bool("mock", false, "Use mock data (no cluster required)")
-	mockScenario = flag.String("scenario", "healthy", "Mock scenario: healthy, partial-ready, missing-runtime, missing-fuse, failed-pods")
-	includePods  = flag.Bool("pods", true, "Include individual pods in output")
-	kubeconfig   = flag.String("kubeconfig", "", "Path to kubeconfig file")
-	showHelp     = flag.Bool("help", false, "Show help")
-	showVersion  = flag.Bool("version", false, "Show version")
+	namespace     = flag.String("n", "default", "Kubernetes namespace, or \"all\" for every namespace")
+	allNamespaces = flag.Bool("all-namespaces", false, "Search every namespace (same as -n all)")
+	outputFormat  = flag.String("o", "tree", "Output format: tree, json, jsonl, yaml, wide, mermaid, csv, summary")
+	jsonQuery     = flag.String("query", "", "Print only the values matched by this JSONPath template over the JSON output, e.g. '{.resources[*].name}'")
+	outputFile    = flag.String("output-file", "", "Write the rendered output to this file instead of stdout (status messages go to stderr); the file is only replaced once output is rendered")
+	mockMode      = flag.Bool("mock", false, "Use mock data (no cluster required)")
+	mockScenario  = flag.String("scenario", "healthy", "Mock scenario: healthy, partial-ready, missing-runtime, missing-fuse, failed-pods, orphaned, stale-revision, dataset-label, scaling, no-endpoints, sidecar-fuse, app-label-only, controller-down, cross-namespace, terminating")
+	runtimeConfig = flag.String("runtime-config", "", "YAML file registering custom runtime types (name, group, version, resource, components, roles)")
+	snapshotDir   = flag.String("snapshot", "", "Read resources from a directory of YAML/JSON dumps (e.g. kubectl get -o yaml) instead of a cluster")
+	includePods   = podsFull
+	selector      = flag.String("l", "", "Additional label selector to scope discovery (e.g. shard=a)")
+	labelKey      = flag.String("label-key", "", "Label holding the release name on runtime resources, e.g. app.kubernetes.io/instance (default: fluid.io/dataset, then release)")
+	fieldSelector = flag.String("field-selector", "", "Field selector scoping pod discovery (e.g. status.phase!=Running)")
+	kinds         = flag.String("kinds", "", "Comma-separated resource kinds to discover (e.g. StatefulSet,Pod)")
+	excludeKinds  = flag.String("exclude-kinds", "", "Comma-separated resource kinds to skip (e.g. ConfigMap,Secret)")
+	explainMode   = flag.Bool("explain", false, "Print a short ranked root-cause summary instead of the resource map (same as the explain command)")
+	onlyWarnings  = flag.Bool("only-warnings", false, "Print only the warnings, most severe first, instead of the resource map")
+	minLevel      = flag.String("min-level", "info", "Lowest warning level shown by --only-warnings: error, warning, info")
+	components    = flag.String("component", "", "Comma-separated components to show: master, worker, fuse, storage, config, operation, controller (warnings are not filtered)")
+	newerThan     = flag.Duration("newer-than", 0, "Show only resources created within this duration (e.g. 30m); parents of matching pods are kept")
+	olderThan     = flag.Duration("older-than", 0, "Show only resources created at least this long ago (e.g. 24h)")
+	recentConds   = flag.Duration("recent-conditions", 0, "In tree output, show the Dataset and Runtime conditions that transitioned within this duration (e.g. 10m)")
+	showImages    = flag.Bool("show-images", false, "In tree output, show the container images of each workload and pod, e.g. to follow a staged upgrade")
+	groupByNode   = flag.Bool("group-by-node", false, "In tree output, also group worker and fuse pods by the node they run on")
+	checkUFS      = flag.Bool("check-ufs", true, "Report Dataset conditions showing a failing UFS mount as UFS_UNREACHABLE")
+	storageClass  = flag.Bool("storage-classes", false, "Follow PVC → PV → StorageClass and report classes that keep a Pending PVC from binding")
+	consumers     = flag.Bool("consumers", false, "Attach the pods mounting the Dataset's PVC under it, to see who still uses the Dataset before deleting it")
+	autoscalers   = flag.Bool("autoscalers", false, "Include the HorizontalPodAutoscalers scaling the workers, with current/desired/min/max replicas")
+	rawSpec       = flag.Bool("raw-spec", false, "Include the unparsed Dataset and Runtime specs in JSON and YAML output")
+	checkCtrl     = flag.Bool("check-controller", false, "When a runtime's master or worker is missing, look up its Fluid controller Deployment and report its state")
+	controllerNs  = flag.String("controller-namespace", mapper.DefaultControllerNamespace, "Namespace the Fluid controllers run in, for --check-controller")
+	ownerChain    = flag.Bool("owner-chain", false, "Resolve the full owner chain of each resource (e.g. Pod → StatefulSet → AlluxioRuntime → Dataset)")
+	kubeconfig    = flag.String("kubeconfig", "", "Path to kubeconfig file")
+	inCluster     = flag.Bool("in-cluster", false, "Use the pod's service account (default when no kubeconfig is found inside a pod)")
+	qps           = flag.Float64("qps", 0, "Maximum API requests per second (default: client-go's 5)")
+	burst         = flag.Int("burst", 0, "Maximum burst of API requests above --qps (default: client-go's 10)")
+	maxRequests   = flag.Int("max-requests", 0, "Maximum API requests in flight at once (default: no limit)")
+	kubeContext   = flag.String("context", "", "Kubeconfig context to use (default: current context)")
+	namePrefix    = flag.Bool("prefix", false, "Treat the dataset name as a prefix and map every Dataset whose name starts with it")
+	kubeContexts  = flag.String("contexts", "", "Comma-separated kubeconfig contexts to map the dataset in at once; results are keyed by cluster name")
+	checkRBAC     = flag.Bool("check-rbac", false, "Before mapping, check that the credentials grant every permission discovery needs")
+	listContexts  = flag.Bool("list-contexts", false, "List the contexts in the kubeconfig and exit")
+	noColor       = flag.Bool("no-color", false, "Use plain ASCII instead of emoji and box drawing (also set by NO_COLOR)")
+	quiet         = flag.Bool("quiet", false, "Do not show the discovery progress indicator on stderr (it is only shown on a terminal)")
+	verbosity     = flag.Int("v", 0, "Log verbosity on stderr: 0 quiet, 1 discovery steps and empty results, 2 per-kind counts and API requests")
+	timeout       = flag.Duration("timeout", 30*time.Second, "Maximum time to spend mapping before returning a partial result")
+	watch         = flag.Bool("watch", false, "Re-map and re-render every --interval until interrupted")
+	interval      = flag.Duration("interval", 5*time.Second, "Polling interval for --watch")
+	podMetrics    = flag.Bool("metrics", false, "Annotate pods with live CPU/memory usage from metrics.k8s.io (requires metrics-server)")
+	alertWebhook  = flag.String("alert-webhook", "", "POST a JSON alert (dataset, cluster, warnings, timestamp) to this URL when the mapping has error-level warnings")
+	listenAddr    = flag.String("listen-addr", ":8080", "Address the serve command listens on")
+	cacheTTL      = flag.Duration("cache-ttl", 30*time.Second, "How long the serve command reuses a mapping before mapping the dataset again (0 disables the cache)")
+	metricsAddr   = flag.String("metrics-addr", "", "Serve Prometheus metrics for the mapping result on this address (e.g. :9090) until interrupted, then exit with the mapping's exit code")
+	emitStatus    = flag.Bool("status-line", false, "After the output, print a one-line JSON outcome (dataset, healthy, errors, warnings, resources, durationMs) to stderr")
+	showCatalog   = flag.Bool("catalog", false, "Print every warning code with its default level, message and suggestion as JSON, then exit")
+	showHelp      = flag.Bool("help", false, "Show help")
+	showVersion   = flag.Bool("version", false, "Show version")
 )
 
+func init() {
+	flag.StringVar(outputFile, "f", "", "Shorthand for --output-file")
+	flag.Var(&includePods, "pods", "Pods under their workloads: full lists each pod, count only shows how many are ready (e.g. --pods=count), false skips them")
+}
+
 func main() {
 	// Reorder args to allow flags after positional arguments
 	args := reorderArgs(os.Args[1:])
 	os.Args = append([]string{os.Args[0]}, args...)
 
-	flag.Usage = usage
+	flag.Usage = func() { usage(os.Stderr) }
 	flag.Parse()
 
 	if *showVersion {
 		fmt.Printf("fluid-resource-mapper version %s\n", version)
-		os.Exit(0)
+		exit(0)
+	}
+
+	if *listContexts {
+		printContexts()
+		exit(0)
+	}
+
+	if *showHelp {
+		usage(os.Stdout)
+		exit(0)
+	}
+	if flag.NArg() < 1 && !*showCatalog {
+		usage(os.Stderr)
+		exit(1)
+	}
+
+	if *runtimeConfig != "" {
+		if err := mapper.LoadRuntimeConfig(*runtimeConfig); err != nil {
+			fmt.Fprintf(os.Stderr, "❌ %v\n", err)
+			exit(1)
+		}
+	}
+
+	if *outputFile != "" {
+		if *watch {
+			fmt.Fprintln(os.Stderr, "❌ --output-file cannot be used with --watch")
+			exit(1)
+		}
+		f, err := openOutputFile(*outputFile)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "❌ Failed to create output file: %v\n", err)
+			exit(1)
+		}
+		// The file is only replaced by exit, once the command has rendered
+		pendingOutput = f
+		stdout = f
 	}
 
-	if *showHelp || flag.NArg() < 1 {
-		usage()
-		os.Exit(0)
+	if *showCatalog {
+		printCatalog()
+		exit(0)
 	}
 
 	command := flag.Arg(0)
@@ -93,28 +215,50 @@ func main() {
 	switch command {
 	case "dataset":
 		mapDataset(resourceName)
+	case "explain":
+		*explainMode = true
+		mapDataset(resourceName)
+	case "health":
+		quickHealth(resourceName)
+	case "verify":
+		verifyDataset(resourceName)
 	case "list":
 		listDatasets()
+	case "serve":
+		serveDatasets()
+	case "diff":
+		diffGraphs(resourceName, flag.Arg(2))
+	case "schema":
+		printSchema()
 	default:
 		fmt.Fprintf(os.Stderr, "Unknown command: %s\n", command)
-		usage()
-		os.Exit(1)
+		usage(os.Stderr)
+		exit(1)
 	}
+	exit(exitOK)
 }
 
-func usage() {
-	fmt.Printf(banner, version)
-	fmt.Println(`
+// usage prints the help text to w: stdout for --help, stderr for a usage error
+func usage(w io.Writer) {
+	fmt.Fprintf(w, banner, version)
+	fmt.Fprintln(w, `
 USAGE:
     mapper-demo <command> <name> [flags]
 
 COMMANDS:
     dataset <name>    Map resources for a Dataset
-    list              List all Datasets in namespace
+    explain <name>    Summarize why a Dataset is unhealthy
+    health <name>     Check a Dataset's health from object counts only
+    verify <name>     Check that each conventionally named resource exists
+    list              List all Datasets in namespace (or -n all)
+    diff <old> <new>  Compare two graphs saved with -o json
+    schema            Print the JSON Schema of the -o json output
+    serve             Serve mappings over HTTP on --listen-addr
 
 FLAGS:`)
+	flag.CommandLine.SetOutput(w)
 	flag.PrintDefaults()
-	fmt.Println(`
+	fmt.Fprintln(w, `
 EXAMPLES:
     # Map a dataset in default namespace
     mapper-demo dataset demo-data
@@ -122,6 +266,12 @@ EXAMPLES:
     # Map a dataset in specific namespace
     mapper-demo dataset demo-data -n fluid-system
 
+    # Find a dataset in whichever namespace it lives in
+    mapper-demo dataset demo-data -n all
+
+    # List datasets across all namespaces
+    mapper-demo list --all-namespaces
+
     # Use mock mode for demo (no cluster needed)
     mapper-demo dataset demo-data --mock
 
@@ -130,265 +280,710 @@ EXAMPLES:
     mapper-demo dataset demo-data --mock --scenario missing-fuse
     mapper-demo dataset demo-data --mock --scenario failed-pods
 
+    # Map a dataset from kubectl dumps collected on a customer cluster
+    mapper-demo dataset demo-data -n fluid-system --snapshot ./dumps
+
+    # Only map resources of one worker shard
+    mapper-demo dataset demo-data -n fluid-system -l shard=a
+
+    # Map a Helm-managed deployment labeled app.kubernetes.io/instance=<name>
+    mapper-demo dataset demo-data -n fluid-system --label-key app.kubernetes.io/instance
+
+    # Only show the pods that are not running
+    mapper-demo dataset demo-data --mock --scenario failed-pods --field-selector status.phase!=Running
+
+    # Only map the workloads, skipping storage and config lookups
+    mapper-demo dataset demo-data --mock --kinds StatefulSet,DaemonSet,Pod
+
+    # Only show the fuse resources (all warnings are still listed)
+    mapper-demo dataset demo-data --mock --component fuse
+
+    # Check whether fuse and worker pods are colocated
+    mapper-demo dataset demo-data --mock --group-by-node
+
+    # Compare live pod usage with limits when chasing OOM kills
+    mapper-demo dataset demo-data --mock --metrics
+
+    # Show only resources created in the last 30 minutes
+    mapper-demo dataset demo-data -n fluid-system --newer-than 30m
+
+    # Show what created each resource, up to the Dataset
+    mapper-demo dataset demo-data --mock -o wide --owner-chain
+
+    # Include the unparsed Dataset and Runtime specs
+    mapper-demo dataset demo-data --mock -o json --raw-spec
+
+    # Map a dataset served by an in-house runtime type
+    mapper-demo dataset my-data -n team-a --runtime-config runtimes.yaml
+
+    # Report missing RBAC permissions before mapping
+    mapper-demo dataset demo-data -n fluid-system --check-rbac
+
+    # Map a dataset in another cluster
+    mapper-demo --list-contexts
+    mapper-demo dataset demo-data -n fluid-system --context prod-east
+    mapper-demo dataset demo-data -n fluid-system --contexts prod-east,prod-west -o json
+
+    # Watch worker pods come Ready during a scale-up
+    mapper-demo dataset demo-data -n fluid-system --watch --interval 5s
+
+    # Show which API calls ran and what each selector matched
+    mapper-demo dataset demo-data -n fluid-system --v 2
+
+    # Run inside the cluster (e.g. as a Job) with the pod's service account
+    mapper-demo dataset demo-data -n fluid-system --in-cluster
+
+    # Go easy on a busy API server during parallel discovery
+    mapper-demo dataset demo-data -n fluid-system --qps 2 --burst 4 --max-requests 2
+
+    # Give up after 10 seconds on a degraded cluster
+    mapper-demo dataset demo-data -n fluid-system --timeout 10s
+
+    # Plain ASCII output for CI logs (also enabled by NO_COLOR=1 or a non-terminal stdout)
+    mapper-demo dataset demo-data --mock --no-color
+
     # Output as JSON
     mapper-demo dataset demo-data --mock -o json
 
+    # Output as YAML
+    mapper-demo dataset demo-data --mock -o yaml
+
+    # Save clean JSON for archival; the mock and snapshot notices go to stderr
+    mapper-demo dataset demo-data --mock -o json -f demo-data.json
+
+    # Output as a Mermaid diagram for Markdown docs
+    mapper-demo dataset demo-data --mock -o mermaid
+
+    # Summarize the likely root causes instead of listing every warning
+    mapper-demo explain demo-data --mock --scenario missing-runtime
+
+    # Workloads missing? Show whether the runtime controller is running
+    mapper-demo dataset demo-data --mock --scenario controller-down --check-controller
+
+    # Who still mounts the Dataset's PVC? Check before deleting it
+    mapper-demo dataset demo-data --mock --consumers
+
+    # CI gate: print only error-level warnings, exit 1 if there are any
+    mapper-demo dataset demo-data -n fluid-system --only-warnings --min-level error
+
+    # List the failed pods without jq
+    mapper-demo dataset demo-data --mock --scenario failed-pods --query '{.resources[*].children[?(@.status.phase=="Failed")].name}'
+
+    # One line per dataset across the cluster, e.g. to find the unhealthy ones
+    mapper-demo list -n all -o summary
+
+    # Output as CSV for spreadsheets
+    mapper-demo dataset demo-data --mock -o csv > resources.csv
+
+    # Capture the outcome in a wrapper script without parsing the graph
+    mapper-demo dataset demo-data -n fluid-system --status-line 2>&1 >/dev/null | tail -1
+
+    # List every warning code with its default help text, e.g. for a UI
+    mapper-demo --catalog
+
+    # Probe a dataset cheaply, e.g. from a liveness script
+    mapper-demo health demo-data -n fluid-system
+
+    # Check resources by their conventional names, catching mislabeled ones
+    mapper-demo verify demo-data -n fluid-system
+
+    # Map every versioned dataset (model-v1, model-v2, ...) at once
+    mapper-demo dataset model --prefix -n fluid-system
+
+    # Export the JSON Schema of the -o json output
+    mapper-demo schema > resource-graph.schema.json
+
+    # Compare mappings taken before and after an upgrade
+    mapper-demo dataset demo-data -o json > before.json
+    mapper-demo dataset demo-data -o json > after.json
+    mapper-demo diff before.json after.json
+
+    # Alert a webhook (e.g. Slack or PagerDuty) from a cron job when the dataset is unhealthy
+    mapper-demo dataset demo-data -n fluid-system --alert-webhook https://hooks.example.com/fluid
+
+    # Serve mappings as JSON for a dashboard: GET /datasets/{namespace}[/{name}]
+    mapper-demo serve -n fluid-system --listen-addr :8080
+
+    # Serve the mapping result as Prometheus metrics on :9090/metrics until Ctrl-C
+    mapper-demo dataset demo-data --mock --metrics-addr :9090
+
+EXIT CODES:
+    0    Dataset mapped and healthy
+    1    Dataset mapped but unhealthy (error-level warnings)
+    2    Cluster unreachable or client creation failed
+    3    Dataset not found
+    4    Access denied (Forbidden or Unauthorized) or RBAC preflight found missing permissions
+
 MOCK SCENARIOS:
     healthy          Fully healthy deployment (default)
     partial-ready    Some pods/workers not ready
     missing-runtime  Dataset without bound Runtime
     missing-fuse     Fuse DaemonSet is missing
-    failed-pods      Worker pods in failed state`)
+    failed-pods      Worker pods in failed state
+    orphaned         Worker StatefulSet and pods without owner references
+    stale-revision   Worker pod left on an old revision after a rollout
+    dataset-label    Resources labeled with fluid.io/dataset (newer Fluid)
+    scaling          Worker StatefulSet scaling from 2 to 3 replicas
+    no-endpoints     Master Service without ready endpoints
+    sidecar-fuse     Fuse injected as a sidecar into application pods
+    app-label-only   Worker resources labeled app=alluxio but not release=<name>
+    controller-down  No runtime workloads; the runtime controller is crash-looping
+    cross-namespace  Runtime and its workloads in fluid-cache, Dataset in default
+    terminating      Runtime, PVC and worker being deleted and held by finalizers`)
 }
 
 func mapDataset(name string) {
-	ctx := context.Background()
+	ctx, cancel := context.WithTimeout(context.Background(), *timeout)
+	defer cancel()
 
-	// Create client
-	var client k8s.Client
-	if *mockMode {
-		scenario := k8s.MockScenario(*mockScenario)
-		client = k8s.NewMockClient(scenario)
-		fmt.Println("🔧 Using MOCK mode - no cluster connection required")
-		fmt.Printf("📋 Scenario: %s\n\n", *mockScenario)
-	} else {
-		realClient, err := k8s.NewClient(k8s.ClientConfig{
-			KubeconfigPath: *kubeconfig,
-		})
+	renderer, ok := renderers[*outputFormat]
+	if !ok {
+		fmt.Fprintf(os.Stderr, "❌ Unknown output format: %s (available: %s)\n", *outputFormat, strings.Join(rendererNames(), ", "))
+		exit(1)
+	}
+
+	if err := validateComponents(splitList(*components)); err != nil {
+		fmt.Fprintf(os.Stderr, "❌ %v\n", err)
+		exit(1)
+	}
+	if err := validateAgeWindow(); err != nil {
+		fmt.Fprintf(os.Stderr, "❌ %v\n", err)
+		exit(1)
+	}
+	if *onlyWarnings && !validLevel(*minLevel) {
+		fmt.Fprintf(os.Stderr, "❌ Unknown warning level: %s (available: error, warning, info)\n", *minLevel)
+		exit(1)
+	}
+
+	if *jsonQuery != "" {
+		if *watch || *namePrefix || *kubeContexts != "" || *onlyWarnings || *explainMode {
+			fmt.Fprintln(os.Stderr, "❌ --query cannot be used with --watch, --prefix, --contexts, --only-warnings or --explain")
+			exit(1)
+		}
+		queryRenderer, err := newQueryRenderer(*jsonQuery)
 		if err != nil {
-			fmt.Fprintf(os.Stderr, "❌ Failed to create Kubernetes client: %v\n", err)
-			fmt.Fprintf(os.Stderr, "\n💡 Tip: Use --mock flag to run without a cluster\n")
-			os.Exit(1)
+			fmt.Fprintf(os.Stderr, "❌ %v\n", err)
+			exit(1)
 		}
-		client = realClient
+		renderer = queryRenderer
+	}
+
+	// Map the dataset
+	opts := mappingOptions()
+
+	if *namePrefix {
+		mapByPrefix(name, opts)
+		return
+	}
+
+	if contexts := splitList(*kubeContexts); len(contexts) > 0 {
+		mapAcrossContexts(name, contexts, opts)
+		return
 	}
 
 	// Create mapper
-	m := mapper.New(client)
+	m := mapper.New(newClient()).WithLogger(newLogger())
 
-	// Map the dataset
-	opts := mapper.Options{
-		IncludePods:    *includePods,
-		IncludeConfigs: true,
-		IncludeStorage: true,
+	if *watch {
+		watchDataset(m, name, opts)
+		return
 	}
 
-	graph, err := m.MapFromDataset(ctx, name, *namespace, opts)
-	if err != nil {
+	progress := startProgress()
+	graph, err := m.WithProgress(progress.Update).MapFromDataset(ctx, name, targetNamespace(), opts)
+	progress.Stop()
+	if err != nil && graph == nil {
 		fmt.Fprintf(os.Stderr, "❌ Mapping failed: %v\n", err)
-		os.Exit(1)
+		printStatusLine(name, nil, err)
+		exit(exitCodeFor(err))
 	}
 
 	// Output
-	switch *outputFormat {
-	case "json":
-		outputJSON(graph)
-	case "wide":
-		outputWide(graph)
-	default:
-		outputTree(graph)
+	out := stdout
+	if (humanFormats[*outputFormat] && *jsonQuery == "") || (*onlyWarnings && *outputFormat != "json") {
+		out = outputWriter()
+	}
+	if *onlyWarnings {
+		renderer = RendererFunc(outputWarnings)
+	}
+	if *explainMode {
+		out = stdout
+		renderer = RendererFunc(outputExplain)
+	}
+	shown := filterGraph(graph)
+	if err := renderer.Render(out, shown); err != nil {
+		renderFailed(err)
+		exit(1)
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "❌ Mapping failed: %v\n", err)
+	}
+	printStatusLine(name, shown, err)
+	sendAlert(graph)
+
+	if *metricsAddr != "" {
+		serveMetrics(*metricsAddr, graph)
+	}
+
+	if err != nil {
+		exit(exitCodeFor(err))
 	}
 
 	// Exit with error code if unhealthy
 	if !graph.IsHealthy() {
-		os.Exit(1)
+		exit(exitUnhealthy)
 	}
 }
 
-func listDatasets() {
-	fmt.Println("📋 Listing datasets in namespace:", *namespace)
-	fmt.Println("(Not yet implemented - use 'dataset <name>' to map a specific dataset)")
+// statusLine is the one-line outcome printed to stderr by --status-line
+type statusLine struct {
+	Dataset    string `json:"dataset"`
+	Healthy    bool   `json:"healthy"`
+	Errors     int    `json:"errors"`
+	Warnings   int    `json:"warnings"`
+	Resources  int    `json:"resources"`
+	DurationMs int64  `json:"durationMs"`
+	Error      string `json:"error,omitempty"`
 }
 
-func outputJSON(graph *types.ResourceGraph) {
-	data, err := json.MarshalIndent(graph, "", "  ")
-	if err != nil {
-		fmt.Fprintf(os.Stderr, "Failed to marshal JSON: %v\n", err)
+// printStatusLine prints the outcome of mapping a dataset as a single JSON
+// object on stderr when --status-line is set. graph may be nil when mapping
+// failed outright; counts reflect the rendered (filtered) graph.
+func printStatusLine(name string, graph *types.ResourceGraph, err error) {
+	if !*emitStatus {
 		return
 	}
-	fmt.Println(string(data))
-}
 
-func outputTree(graph *types.ResourceGraph) {
-	// Print header
-	fmt.Println(strings.Repeat("─", 60))
-	fmt.Printf("📊 Resource Map for Dataset: %s/%s\n", graph.Dataset.Namespace, graph.Dataset.Name)
-	fmt.Println(strings.Repeat("─", 60))
-
-	// Dataset info
-	datasetIcon := phaseIcon(graph.Dataset.Phase)
-	fmt.Printf("\n%s Dataset: %s (%s)\n", datasetIcon, graph.Dataset.Name, graph.Dataset.Phase)
-	if graph.Dataset.UfsTotal != "" {
-		fmt.Printf("   📁 UFS Total: %s", graph.Dataset.UfsTotal)
-		if graph.Dataset.Cached != "" {
-			fmt.Printf(" | Cached: %s (%s)", graph.Dataset.Cached, graph.Dataset.CachedPercentage)
-		}
-		fmt.Println()
-	}
-
-	// Runtime info
-	if graph.Runtime != nil {
-		fmt.Printf("│\n└── 🔧 Runtime: %s (%s)\n", graph.Runtime.Name, graph.Runtime.Type)
-
-		// Group resources by component
-		masters := graph.GetResourcesByComponent(types.ComponentMaster)
-		workers := graph.GetResourcesByComponent(types.ComponentWorker)
-		fuses := graph.GetResourcesByComponent(types.ComponentFuse)
-		storage := graph.GetResourcesByComponent(types.ComponentStorage)
-		configs := graph.GetResourcesByComponent(types.ComponentConfig)
-
-		// Print Master
-		if len(masters) > 0 {
-			for i, r := range masters {
-				prefix := "    ├──"
-				if i == len(masters)-1 && len(workers) == 0 && len(fuses) == 0 && len(storage) == 0 {
-					prefix = "    └──"
-				}
-				fmt.Printf("%s %s %s: %s %s\n", prefix, r.Status.Phase.StatusIcon(), r.Kind, r.Name, colorReady(r.Status.Ready))
-				printPodChildren(r.Children, "    │")
+	status := statusLine{Dataset: name}
+	if err != nil {
+		status.Error = err.Error()
+	}
+	if graph != nil {
+		status.Healthy = graph.IsHealthy() && err == nil
+		for _, w := range graph.Warnings {
+			switch w.Level {
+			case types.WarningLevelError:
+				status.Errors++
+			case types.WarningLevelWarning:
+				status.Warnings++
 			}
-		} else if graph.Runtime.MasterPhase != "" {
-			fmt.Printf("    ├── ✗ Master: MISSING\n")
 		}
-
-		// Print Workers
-		if len(workers) > 0 {
-			for i, r := range workers {
-				prefix := "    ├──"
-				if i == len(workers)-1 && len(fuses) == 0 && len(storage) == 0 {
-					prefix = "    └──"
-				}
-				fmt.Printf("%s %s %s: %s %s\n", prefix, r.Status.Phase.StatusIcon(), r.Kind, r.Name, colorReady(r.Status.Ready))
-				printPodChildren(r.Children, "    │")
-			}
-		} else {
-			fmt.Printf("    ├── ✗ Worker: MISSING\n")
+		status.Resources = len(graph.Resources)
+		if d, parseErr := time.ParseDuration(graph.Metadata.Duration); parseErr == nil {
+			status.DurationMs = d.Milliseconds()
 		}
+	}
 
-		// Print Fuse
-		if len(fuses) > 0 {
-			for i, r := range fuses {
-				prefix := "    ├──"
-				if i == len(fuses)-1 && len(storage) == 0 && len(configs) == 0 {
-					prefix = "    └──"
-				}
-				fmt.Printf("%s %s %s: %s %s\n", prefix, r.Status.Phase.StatusIcon(), r.Kind, r.Name, colorReady(r.Status.Ready))
-			}
-		} else {
-			fmt.Printf("    ├── ⚠ Fuse: Not deployed (on-demand)\n")
-		}
+	line, _ := json.Marshal(status)
+	fmt.Fprintln(os.Stderr, string(line))
+}
 
-		// Print Storage
-		if len(storage) > 0 {
-			fmt.Printf("    │\n")
-			fmt.Printf("    ├── 💾 Storage\n")
-			for i, r := range storage {
-				prefix := "    │   ├──"
-				if i == len(storage)-1 && len(configs) == 0 {
-					prefix = "    │   └──"
-				}
-				fmt.Printf("%s %s %s: %s\n", prefix, r.Status.Phase.StatusIcon(), r.Kind, r.Name)
-			}
-		}
+// mappingOptions builds the mapper options selected by the flags
+func mappingOptions() mapper.Options {
+	return mapper.Options{
+		IncludePods:           includePods != podsOff,
+		PodCounts:             includePods == podsCount,
+		IncludeConfigs:        true,
+		IncludeStorage:        true,
+		IncludeDataOperations: true,
+		ExtraLabelSelector:    *selector,
+		PodFieldSelector:      *fieldSelector,
+		LabelKey:              *labelKey,
+		IncludeKinds:          splitList(*kinds),
+		ExcludeKinds:          splitList(*excludeKinds),
+		ResolveOwnerChain:     *ownerChain,
+		IncludeUsage:          *podMetrics,
+		IncludeStorageClasses: *storageClass,
+		IncludeConsumers:      *consumers,
+		IncludeAutoscalers:    *autoscalers,
+		IncludeRawSpec:        *rawSpec,
+		CheckUFS:              *checkUFS,
+		CheckController:       *checkCtrl,
+		ControllerNamespace:   *controllerNs,
+	}
+}
 
-		// Print Configs
-		if len(configs) > 0 {
-			fmt.Printf("    │\n")
-			fmt.Printf("    └── ⚙️  Configuration\n")
-			for i, r := range configs {
-				prefix := "        ├──"
-				if i == len(configs)-1 {
-					prefix = "        └──"
-				}
-				fmt.Printf("%s %s %s: %s\n", prefix, r.Status.Phase.StatusIcon(), r.Kind, r.Name)
-			}
+// podsMode is the value of --pods. It is a boolean flag, so a bare --pods
+// and --pods=true mean full as they did before count existed.
+type podsMode string
+
+const (
+	podsOff   podsMode = "false"
+	podsCount podsMode = "count"
+	podsFull  podsMode = "full"
+)
+
+func (p *podsMode) String() string { return string(*p) }
+
+func (p *podsMode) Set(value string) error {
+	switch podsMode(value) {
+	case podsOff, podsCount, podsFull:
+		*p = podsMode(value)
+	case "true":
+		*p = podsFull
+	default:
+		return fmt.Errorf("must be false, count or full")
+	}
+	return nil
+}
+
+func (p *podsMode) IsBoolFlag() bool { return true }
+
+// splitList splits a comma-separated flag value, dropping empty entries
+func splitList(value string) []string {
+	var items []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
 		}
-	} else {
-		fmt.Printf("│\n└── ⚠ No Runtime bound\n")
 	}
+	return items
+}
 
-	// Print warnings
-	if len(graph.Warnings) > 0 {
-		fmt.Printf("\n%s\n", strings.Repeat("─", 60))
-		fmt.Printf("⚠️  Warnings (%d)\n", len(graph.Warnings))
-		fmt.Println(strings.Repeat("─", 60))
-		for _, w := range graph.Warnings {
-			fmt.Printf("%s [%s] %s\n", w.Level.StatusIcon(), w.Code, w.Message)
-			if w.Suggestion != "" {
-				fmt.Printf("   💡 %s\n", w.Suggestion)
-			}
+// exitCodeFor maps a mapping error to the CLI exit code
+func exitCodeFor(err error) int {
+	switch {
+	case err == nil:
+		return exitOK
+	case errors.Is(err, mapper.ErrDatasetNotFound):
+		return exitDatasetNotFound
+	case errors.Is(err, mapper.ErrClusterUnreachable):
+		return exitClusterError
+	case errors.Is(err, mapper.ErrAccessDenied):
+		return exitAccessDenied
+	default:
+		return exitUnhealthy
+	}
+}
+
+// serveMetrics exposes the mapping result on addr/metrics until SIGINT or
+// SIGTERM, then shuts the server down and returns so the caller can still
+// exit with the mapping's exit code
+func serveMetrics(addr string, graph *types.ResourceGraph) {
+	reg := prometheus.NewRegistry()
+	metrics.NewCollector(reg).Update(graph)
+
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", promhttp.HandlerFor(reg, promhttp.HandlerOpts{}))
+	server := &http.Server{Addr: addr, Handler: mux, ReadHeaderTimeout: 10 * time.Second}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	go func() {
+		<-ctx.Done()
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), *timeout)
+		defer cancel()
+		_ = server.Shutdown(shutdownCtx)
+	}()
+
+	fmt.Fprintf(os.Stderr, "📈 Serving metrics on %s/metrics until interrupted\n", addr)
+	if err := server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		fmt.Fprintf(os.Stderr, "❌ Metrics server failed: %v\n", err)
+		exit(1)
+	}
+}
+
+// alertTimeout bounds delivering one --alert-webhook alert, retries included.
+// It is separate from --timeout so that a slow mapping leaves the alert its own time.
+const alertTimeout = 30 * time.Second
+
+// sendAlert POSTs the graph to --alert-webhook when it is unhealthy. A failed
+// delivery is reported but does not change the exit code.
+func sendAlert(graph *types.ResourceGraph) {
+	if *alertWebhook == "" {
+		return
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), alertTimeout)
+	defer cancel()
+	sent, err := notify.NewWebhook(*alertWebhook).Notify(ctx, graph)
+	switch {
+	case err != nil:
+		fmt.Fprintf(os.Stderr, "❌ Failed to send alert for %s/%s: %v\n", graph.Dataset.Namespace, graph.Dataset.Name, err)
+	case sent:
+		fmt.Fprintf(os.Stderr, "📨 Alert sent for %s/%s\n", graph.Dataset.Namespace, graph.Dataset.Name)
+	}
+}
+
+// newClient creates the mock, snapshot or real Kubernetes client selected by the flags
+func newClient() k8s.Client {
+	if *mockMode && *snapshotDir != "" {
+		fmt.Fprintln(os.Stderr, "❌ --mock and --snapshot cannot be used together")
+		exit(1)
+	}
+	if *checkRBAC && (*mockMode || *snapshotDir != "") {
+		fmt.Fprintln(os.Stderr, "ℹ️  --check-rbac skipped: not connected to a cluster")
+	}
+
+	if *snapshotDir != "" {
+		client, err := k8s.NewSnapshotClient(*snapshotDir)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "❌ Failed to load snapshot: %v\n", err)
+			exit(exitClusterError)
 		}
+		fmt.Fprintf(os.Stderr, "📁 Using SNAPSHOT mode - reading resources from %s\n\n", *snapshotDir)
+		return client
 	}
 
-	// Print summary
-	fmt.Printf("\n%s\n", strings.Repeat("─", 60))
-	fmt.Printf("📈 Summary: %d resources mapped in %s\n", len(graph.Resources), graph.Metadata.Duration)
-	if graph.IsHealthy() {
-		fmt.Println("✅ Status: HEALTHY")
-	} else {
-		fmt.Println("❌ Status: UNHEALTHY")
+	if *mockMode {
+		scenario := k8s.MockScenario(*mockScenario)
+		fmt.Fprintln(os.Stderr, "🔧 Using MOCK mode - no cluster connection required")
+		fmt.Fprintf(os.Stderr, "📋 Scenario: %s\n\n", *mockScenario)
+		return k8s.NewMockClient(scenario)
+	}
+
+	client, err := k8s.NewClient(k8s.ClientConfig{
+		KubeconfigPath:        *kubeconfig,
+		Context:               *kubeContext,
+		InCluster:             *inCluster,
+		Logger:                newLogger(),
+		QPS:                   float32(*qps),
+		Burst:                 *burst,
+		MaxConcurrentRequests: *maxRequests,
+	})
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "❌ Failed to create Kubernetes client: %v\n", err)
+		fmt.Fprintf(os.Stderr, "\n💡 Tip: Use --mock flag to run without a cluster\n")
+		exit(exitClusterError)
+	}
+	if *checkRBAC {
+		preflightRBAC(client)
 	}
-	fmt.Println(strings.Repeat("─", 60))
+	return client
 }
 
-func outputWide(graph *types.ResourceGraph) {
-	outputTree(graph)
-	fmt.Println("\n📋 Detailed Resource List:")
-	fmt.Println(strings.Repeat("─", 100))
-	fmt.Printf("%-20s %-30s %-15s %-10s %-15s\n", "KIND", "NAME", "COMPONENT", "STATUS", "AGE")
-	fmt.Println(strings.Repeat("─", 100))
-	for _, r := range graph.Resources {
-		fmt.Printf("%-20s %-30s %-15s %-10s %-15s\n",
-			r.Kind,
-			truncate(r.Name, 28),
-			r.Component,
-			r.Status.Ready,
-			r.Status.Age,
-		)
-	}
-	fmt.Println(strings.Repeat("─", 100))
+// preflightRBAC lists the permissions discovery needs but the client lacks,
+// and exits with exitAccessDenied if there are any
+func preflightRBAC(client *k8s.RealClient) {
+	ctx, cancel := context.WithTimeout(context.Background(), *timeout)
+	defer cancel()
+
+	ns := targetNamespace()
+	missing, err := client.MissingPermissions(ctx, ns, k8s.RequiredPermissions(*podMetrics))
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "❌ RBAC preflight failed: %v\n", err)
+		exit(exitClusterError)
+	}
+	if len(missing) == 0 {
+		fmt.Fprintln(os.Stderr, "✅ RBAC preflight passed")
+		return
+	}
+
+	scope, nsFlag := "namespace "+ns, "-n "+ns
+	if ns == mapper.AllNamespaces {
+		scope, nsFlag = "all namespaces", "--all-namespaces"
+	}
+	fmt.Fprintf(os.Stderr, "❌ RBAC preflight failed: missing %d permission(s) in %s:\n", len(missing), scope)
+	for _, p := range missing {
+		fmt.Fprintf(os.Stderr, "   - %s\n", p)
+	}
+	fmt.Fprintf(os.Stderr, "\n💡 Tip: Grant them with a Role or ClusterRole, then confirm with: kubectl auth can-i %s %s\n", missing[0], nsFlag)
+	exit(exitAccessDenied)
 }
 
-func printPodChildren(children []types.K8sResourceNode, indent string) {
-	for i, pod := range children {
-		prefix := indent + "   ├──"
-		if i == len(children)-1 {
-			prefix = indent + "   └──"
+// printSchema prints the JSON Schema describing the ResourceGraph output
+func printSchema() {
+	schema, err := types.Schema()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "❌ Failed to generate schema: %v\n", err)
+		exit(1)
+	}
+	fmt.Fprintln(stdout, string(schema))
+}
+
+// printCatalog prints the template of every warning code as JSON
+func printCatalog() {
+	encoder := json.NewEncoder(stdout)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(types.WarningCatalog()); err != nil {
+		renderFailed(err)
+		exit(1)
+	}
+}
+
+// newLogger returns a stderr logger for the --v level, or nil when quiet
+func newLogger() *slog.Logger {
+	if *verbosity <= 0 {
+		return nil
+	}
+	level := slog.LevelInfo
+	if *verbosity >= 2 {
+		level = slog.LevelDebug
+	}
+	return slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{Level: level}))
+}
+
+// printContexts prints the contexts defined in the kubeconfig
+func printContexts() {
+	contexts, err := k8s.ListContexts(*kubeconfig)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "❌ Failed to list contexts: %v\n", err)
+		exit(exitClusterError)
+	}
+	for _, name := range contexts {
+		fmt.Println(name)
+	}
+}
+
+// targetNamespace returns the namespace to operate on, honoring -n all and --all-namespaces
+func targetNamespace() string {
+	if *allNamespaces || *namespace == "all" {
+		return mapper.AllNamespaces
+	}
+	return *namespace
+}
+
+func listDatasets() {
+	ctx, cancel := context.WithTimeout(context.Background(), *timeout)
+	defer cancel()
+
+	m := mapper.New(newClient()).WithLogger(newLogger())
+	datasets, err := m.ListDatasets(ctx, targetNamespace())
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "❌ Failed to list datasets: %v\n", err)
+		exit(exitCodeFor(err))
+	}
+
+	if *outputFormat == "summary" {
+		summarizeDatasets(ctx, m, datasets)
+		return
+	}
+
+	if *outputFormat == "json" {
+		encoder := json.NewEncoder(stdout)
+		encoder.SetIndent("", "  ")
+		if err := encoder.Encode(datasets); err != nil {
+			renderFailed(err)
+			exit(1)
 		}
-		icon := "🟢"
-		if pod.Status.Phase != types.PhaseReady && string(pod.Status.Phase) != "Running" {
-			icon = "🟡"
-			if pod.Status.Phase == types.PhaseFailed {
-				icon = "🔴"
-			}
+		return
+	}
+
+	if len(datasets) == 0 {
+		fmt.Fprintln(os.Stderr, "No datasets found")
+		return
+	}
+
+	w := tabwriter.NewWriter(stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "NAMESPACE\tNAME\tPHASE\tRUNTIMES\tCACHED")
+	for _, dataset := range datasets {
+		var runtimes []string
+		for _, ref := range dataset.Runtimes {
+			runtimes = append(runtimes, fmt.Sprintf("%s (%s)", ref.Name, ref.Type))
+		}
+		if len(runtimes) == 0 {
+			runtimes = []string{"-"}
 		}
-		fmt.Printf("%s %s Pod: %s (%s)\n", prefix, icon, pod.Name, pod.Status.Message)
+		cached := dataset.CachedPercentage
+		if cached == "" {
+			cached = "-"
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", dataset.Namespace, dataset.Name, dataset.Phase, strings.Join(runtimes, ", "), cached)
 	}
+	w.Flush()
 }
 
-func phaseIcon(phase string) string {
-	switch phase {
-	case "Bound", "Ready":
-		return "✓"
-	case "NotBound", "NotReady", "Pending":
-		return "⚠"
-	case "Failed":
-		return "✗"
-	default:
-		return "?"
+// quickHealth prints the count-based health verdict of a dataset and exits
+// with exitUnhealthy when it is not healthy
+func quickHealth(name string) {
+	if name == "" {
+		fmt.Fprintln(os.Stderr, "❌ health requires a Dataset name")
+		exit(1)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), *timeout)
+	defer cancel()
+
+	m := mapper.New(newClient()).WithLogger(newLogger())
+	health, err := m.QuickHealth(ctx, name, targetNamespace())
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "❌ Health check failed: %v\n", err)
+		exit(exitCodeFor(err))
+	}
+
+	if *outputFormat == "json" {
+		encoder := json.NewEncoder(stdout)
+		encoder.SetIndent("", "  ")
+		if err := encoder.Encode(health); err != nil {
+			renderFailed(err)
+			exit(1)
+		}
+	} else {
+		out := outputWriter()
+		verdict := "✅ Healthy"
+		if !health.Healthy {
+			verdict = "❌ Unhealthy"
+		}
+		fmt.Fprintf(out, "%s: %s/%s (%s)\n", verdict, health.Namespace, health.Dataset, health.Phase)
+		fmt.Fprintf(out, "   StatefulSets: %d, DaemonSets: %d, Pods: %d\n",
+			health.Counts["StatefulSet"], health.Counts["DaemonSet"], health.Counts["Pod"])
+		for _, reason := range health.Reasons {
+			fmt.Fprintf(out, "   - %s\n", reason)
+		}
+	}
+
+	if !health.Healthy {
+		exit(exitUnhealthy)
 	}
 }
 
-func colorReady(ready string) string {
-	if ready == "" {
-		return ""
+// verifyDataset prints whether each conventionally named resource of the
+// dataset exists and exits with exitUnhealthy when a required one does not
+func verifyDataset(name string) {
+	if name == "" {
+		fmt.Fprintln(os.Stderr, "❌ verify requires a Dataset name")
+		exit(1)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), *timeout)
+	defer cancel()
+
+	m := mapper.New(newClient()).WithLogger(newLogger())
+	report, err := m.Verify(ctx, name, targetNamespace(), mappingOptions())
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "❌ Verification failed: %v\n", err)
+		exit(max(exitCodeFor(err), exitUnhealthy))
+	}
+
+	if *outputFormat == "json" {
+		encoder := json.NewEncoder(stdout)
+		encoder.SetIndent("", "  ")
+		if err := encoder.Encode(report); err != nil {
+			renderFailed(err)
+			exit(1)
+		}
+	} else {
+		w := tabwriter.NewWriter(outputWriter(), 0, 0, 2, ' ', 0)
+		fmt.Fprintln(w, "STATUS\tKIND\tNAME\tCOMPONENT\tNOTE")
+		for _, c := range report.Checks {
+			note := c.Message
+			if c.Optional && note == "" {
+				note = "optional"
+			}
+			if note == "" {
+				note = "-"
+			}
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", c.Status, c.Kind, c.Name, c.Component, note)
+		}
+		w.Flush()
+	}
+
+	if !report.Passed() {
+		exit(exitUnhealthy)
 	}
-	return fmt.Sprintf("(%s)", ready)
 }
 
-func truncate(s string, max int) string {
-	if len(s) <= max {
-		return s
+// summarizeDatasets maps each dataset and prints one summary line per dataset
+func summarizeDatasets(ctx context.Context, m *mapper.Mapper, datasets []types.DatasetNode) {
+	out := outputWriter()
+	for _, dataset := range datasets {
+		graph, err := m.MapFromDataset(ctx, dataset.Name, dataset.Namespace, mappingOptions())
+		if err != nil && graph == nil {
+			fmt.Fprintf(os.Stderr, "❌ Mapping %s/%s failed: %v\n", dataset.Namespace, dataset.Name, err)
+			continue
+		}
+		if err := outputSummary(out, graph); err != nil {
+			renderFailed(err)
+			exit(1)
+		}
 	}
-	return s[:max-2] + ".."
 }
@@ -0,0 +1,33 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/fluid-cloudnative/fluid-resource-mapper/pkg/mapper"
+)
+
+func TestExitCodeFor(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want int
+	}{
+		{"no error", nil, exitOK},
+		{"dataset not found", mapper.ErrDatasetNotFound, exitDatasetNotFound},
+		{"wrapped dataset not found", fmt.Errorf("%w: demo-data", mapper.ErrDatasetNotFound), exitDatasetNotFound},
+		{"dataset ambiguous", mapper.ErrDatasetAmbiguous, exitUnhealthy},
+		{"cluster unreachable", fmt.Errorf("%w: connection refused", mapper.ErrClusterUnreachable), exitClusterError},
+		{"access denied", fmt.Errorf("%w: forbidden", mapper.ErrAccessDenied), exitAccessDenied},
+		{"other error", errors.New("invalid field selector"), exitUnhealthy},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := exitCodeFor(tt.err); got != tt.want {
+				t.Errorf("exitCodeFor(%v) = %d, want %d", tt.err, got, tt.want)
+			}
+		})
+	}
+}
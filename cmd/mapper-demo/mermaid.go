@@ -0,0 +1,88 @@
+// Package main Mermaid diagram renderer
+package main
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/fluid-cloudnative/fluid-resource-mapper/pkg/types"
+)
+
+// mermaidComponents lists the subgraphs rendered by outputMermaid, in order
+var mermaidComponents = []struct {
+	component types.ComponentType
+	title     string
+}{
+	{types.ComponentMaster, "Master"},
+	{types.ComponentWorker, "Worker"},
+	{types.ComponentFuse, "Fuse"},
+	{types.ComponentStorage, "Storage"},
+	{types.ComponentConfig, "Configuration"},
+	{types.ComponentOperation, "Data Operations"},
+	{types.ComponentController, "Runtime Controller"},
+}
+
+// outputMermaid renders the graph as a fenced Mermaid "graph TD" block that can
+// be pasted into GitHub issues and Markdown runbooks
+func outputMermaid(w io.Writer, graph *types.ResourceGraph) error {
+	fmt.Fprintln(w, "```mermaid")
+	fmt.Fprintln(w, "graph TD")
+	fmt.Fprintf(w, "    dataset[%s]\n", mermaidLabel("Dataset", graph.Dataset.Name, graph.Dataset.Phase))
+
+	// Workloads and configs hang off the runtime, storage and data operations off the dataset
+	root := "dataset"
+	ids := make(map[string]string)
+	if graph.Runtime != nil {
+		fmt.Fprintf(w, "    runtime[%s]\n", mermaidLabel("Runtime", graph.Runtime.Name, string(graph.Runtime.Type)))
+		fmt.Fprintln(w, "    dataset --> runtime")
+		root = "runtime"
+	}
+
+	for _, c := range mermaidComponents {
+		resources := graph.GetResourcesByComponent(c.component)
+		if len(resources) == 0 {
+			continue
+		}
+
+		var edges []string
+		fmt.Fprintf(w, "    subgraph %s[\"%s\"]\n", c.component, c.title)
+		for i, r := range resources {
+			id := fmt.Sprintf("%s%d", c.component, i)
+			ids[r.Kind+"/"+r.Name] = id
+			fmt.Fprintf(w, "        %s[%s]\n", id, mermaidLabel(r.Kind, r.Name, r.Status.Ready))
+			for j, child := range r.Children {
+				childID := fmt.Sprintf("%s_%d", id, j)
+				fmt.Fprintf(w, "        %s[%s]\n", childID, mermaidLabel(child.Kind, child.Name, string(child.Status.Phase)))
+				edges = append(edges, id+" --> "+childID)
+			}
+
+			parent := root
+			if c.component == types.ComponentStorage || c.component == types.ComponentOperation {
+				parent = "dataset"
+			}
+			if r.Owner != nil {
+				if ownerID, ok := ids[r.Owner.Kind+"/"+r.Owner.Name]; ok {
+					parent = ownerID
+				}
+			}
+			edges = append(edges, parent+" --> "+id)
+		}
+		fmt.Fprintln(w, "    end")
+		for _, e := range edges {
+			fmt.Fprintf(w, "    %s\n", e)
+		}
+	}
+
+	_, err := fmt.Fprintln(w, "```")
+	return err
+}
+
+// mermaidLabel builds a quoted node label from kind, name and an optional status
+func mermaidLabel(kind, name, status string) string {
+	label := kind + ": " + name
+	if status != "" {
+		label += "<br/>" + status
+	}
+	return `"` + strings.ReplaceAll(label, `"`, "#quot;") + `"`
+}
@@ -0,0 +1,905 @@
+// Package main output renderers
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	"sigs.k8s.io/yaml"
+
+	"github.com/fluid-cloudnative/fluid-resource-mapper/pkg/mapper"
+	"github.com/fluid-cloudnative/fluid-resource-mapper/pkg/types"
+)
+
+// Renderer writes a ResourceGraph in a specific output format
+type Renderer interface {
+	Render(w io.Writer, graph *types.ResourceGraph) error
+}
+
+// RendererFunc adapts a plain function to the Renderer interface
+type RendererFunc func(w io.Writer, graph *types.ResourceGraph) error
+
+// Render calls f(w, graph)
+func (f RendererFunc) Render(w io.Writer, graph *types.ResourceGraph) error {
+	return f(w, graph)
+}
+
+// renderers maps -o values to their Renderer
+var renderers = map[string]Renderer{
+	"tree":    RendererFunc(outputTree),
+	"json":    RendererFunc(outputJSON),
+	"jsonl":   RendererFunc(outputJSONL),
+	"yaml":    RendererFunc(outputYAML),
+	"wide":    RendererFunc(outputWide),
+	"mermaid": RendererFunc(outputMermaid),
+	"csv":     RendererFunc(outputCSV),
+	"summary": RendererFunc(outputSummary),
+}
+
+// humanFormats are the formats decorated with emoji and box drawing, which
+// are translated to plain ASCII when color is disabled
+var humanFormats = map[string]bool{
+	"tree":    true,
+	"wide":    true,
+	"summary": true,
+}
+
+// componentTypes are the values accepted by --component
+var componentTypes = []types.ComponentType{
+	types.ComponentMaster,
+	types.ComponentWorker,
+	types.ComponentFuse,
+	types.ComponentStorage,
+	types.ComponentConfig,
+	types.ComponentOperation,
+	types.ComponentController,
+}
+
+// validateComponents rejects --component values that are not component types
+func validateComponents(names []string) error {
+	for _, name := range names {
+		known := false
+		for _, c := range componentTypes {
+			known = known || types.ComponentType(name) == c
+		}
+		if !known {
+			return fmt.Errorf("unknown component: %s (available: master, worker, fuse, storage, config, operation, controller)", name)
+		}
+	}
+	return nil
+}
+
+// filterComponents returns a copy of the graph holding only the resources of
+// the --component types. Warnings are kept so no problem is hidden.
+func filterComponents(graph *types.ResourceGraph) *types.ResourceGraph {
+	selected := splitList(*components)
+	if len(selected) == 0 {
+		return graph
+	}
+
+	filtered := *graph
+	filtered.Resources = nil
+	for _, c := range componentTypes {
+		if componentShown(c) {
+			filtered.Resources = append(filtered.Resources, graph.GetResourcesByComponent(c)...)
+		}
+	}
+	return &filtered
+}
+
+// filterGraph applies the --component and --newer-than/--older-than filters
+func filterGraph(graph *types.ResourceGraph) *types.ResourceGraph {
+	return filterAge(filterComponents(graph))
+}
+
+// filterAge returns a copy of the graph holding only the resources created
+// within the --newer-than/--older-than window, measured from when the graph
+// was mapped. A resource outside the window is kept if one of its children is
+// inside it, with only those children. Warnings are kept.
+func filterAge(graph *types.ResourceGraph) *types.ResourceGraph {
+	if *newerThan == 0 && *olderThan == 0 {
+		return graph
+	}
+
+	filtered := *graph
+	filtered.Resources = nil
+	for _, r := range graph.Resources {
+		var children []types.K8sResourceNode
+		for _, child := range r.Children {
+			if inAgeWindow(child, graph.Metadata.MappedAt) {
+				children = append(children, child)
+			}
+		}
+		if len(children) == 0 && !inAgeWindow(r, graph.Metadata.MappedAt) {
+			continue
+		}
+		r.Children = children
+		filtered.Resources = append(filtered.Resources, r)
+	}
+	return &filtered
+}
+
+// inAgeWindow reports whether a resource was created within the
+// --newer-than/--older-than window before now. Resources without a
+// creation time are outside every window.
+func inAgeWindow(r types.K8sResourceNode, now time.Time) bool {
+	if r.Status.CreatedAt.IsZero() {
+		return false
+	}
+	age := now.Sub(r.Status.CreatedAt)
+	if *newerThan > 0 && age > *newerThan {
+		return false
+	}
+	return *olderThan == 0 || age >= *olderThan
+}
+
+// validateAgeWindow rejects negative or empty --newer-than/--older-than windows
+func validateAgeWindow() error {
+	if *newerThan < 0 || *olderThan < 0 || *recentConds < 0 {
+		return fmt.Errorf("--newer-than, --older-than and --recent-conditions must not be negative")
+	}
+	if *newerThan > 0 && *olderThan > 0 && *newerThan <= *olderThan {
+		return fmt.Errorf("--newer-than (%s) must be longer than --older-than (%s)", *newerThan, *olderThan)
+	}
+	return nil
+}
+
+// componentShown reports whether --component selects the component
+func componentShown(component types.ComponentType) bool {
+	selected := splitList(*components)
+	if len(selected) == 0 {
+		return true
+	}
+	for _, name := range selected {
+		if types.ComponentType(name) == component {
+			return true
+		}
+	}
+	return false
+}
+
+// rendererNames returns the registered output format names in sorted order
+func rendererNames() []string {
+	names := make([]string, 0, len(renderers))
+	for name := range renderers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// outputSummary renders the graph as a single line with its health grade and
+// resource and warning counts, for scripting over many datasets
+func outputSummary(w io.Writer, graph *types.ResourceGraph) error {
+	_, grade := graph.HealthScore()
+	_, err := fmt.Fprintf(w, "%s | %s | %s | %s | %s\n", summaryTitle(graph), grade,
+		plural(len(graph.Resources), "resource"), readinessLine(graph), plural(len(graph.Warnings), "warning"))
+	return err
+}
+
+// summaryTitle is ResourceGraph.Summary with the Dataset qualified by its
+// namespace, since -o summary lines may come from several namespaces
+func summaryTitle(graph *types.ResourceGraph) string {
+	name := graph.Dataset.Name
+	if graph.Dataset.Namespace != "" {
+		name = graph.Dataset.Namespace + "/" + name
+	}
+	if graph.Runtime == nil {
+		return "Dataset: " + name + " (No Runtime)"
+	}
+	return "Dataset: " + name + " → " + string(graph.Runtime.Type) + " Runtime"
+}
+
+// readinessLine formats the share of ready resources, e.g. "9/11 ready (82%)"
+func readinessLine(graph *types.ResourceGraph) string {
+	ready, total := graph.Readiness()
+	if total == 0 {
+		return "0/0 ready"
+	}
+	return fmt.Sprintf("%d/%d ready (%d%%)", ready, total, int(math.Round(100*float64(ready)/float64(total))))
+}
+
+// plural formats a count with its noun, e.g. "1 warning" or "3 warnings"
+func plural(n int, noun string) string {
+	if n == 1 {
+		return fmt.Sprintf("%d %s", n, noun)
+	}
+	return fmt.Sprintf("%d %ss", n, noun)
+}
+
+// outputJSON renders the graph as indented JSON
+func outputJSON(w io.Writer, graph *types.ResourceGraph) error {
+	data, err := json.MarshalIndent(graph, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal JSON: %w", err)
+	}
+	_, err = fmt.Fprintln(w, string(data))
+	return err
+}
+
+// outputJSONL renders the dataset, runtimes, resources and warnings as one JSON
+// object per line, each tagged with a "recordType" field, for log pipelines
+func outputJSONL(w io.Writer, graph *types.ResourceGraph) error {
+	if err := writeJSONLRecord(w, "dataset", graph.Dataset); err != nil {
+		return err
+	}
+	for _, runtime := range runtimes(graph) {
+		if err := writeJSONLRecord(w, "runtime", runtime); err != nil {
+			return err
+		}
+	}
+	for _, r := range graph.Resources {
+		if err := writeJSONLRecord(w, "resource", r); err != nil {
+			return err
+		}
+	}
+	for _, warning := range graph.Warnings {
+		if err := writeJSONLRecord(w, "warning", warning); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// writeJSONLRecord writes v as a single JSON line with recordType as its first field
+func writeJSONLRecord(w io.Writer, recordType string, v interface{}) error {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Errorf("failed to marshal %s record: %w", recordType, err)
+	}
+
+	record := fmt.Sprintf(`{"recordType":%q`, recordType)
+	if fields := data[1 : len(data)-1]; len(fields) > 0 {
+		record += "," + string(fields)
+	}
+	_, err = fmt.Fprintln(w, record+"}")
+	return err
+}
+
+// outputYAML renders the graph as YAML using the same field names as the JSON output
+func outputYAML(w io.Writer, graph *types.ResourceGraph) error {
+	data, err := yaml.Marshal(graph)
+	if err != nil {
+		return fmt.Errorf("failed to marshal YAML: %w", err)
+	}
+	_, err = w.Write(data)
+	return err
+}
+
+// outputTree renders the human-readable hierarchical view
+func outputTree(w io.Writer, graph *types.ResourceGraph) error {
+	// Print header
+	fmt.Fprintln(w, strings.Repeat("─", 60))
+	fmt.Fprintf(w, "📊 Resource Map for Dataset: %s/%s\n", graph.Dataset.Namespace, graph.Dataset.Name)
+	fmt.Fprintln(w, strings.Repeat("─", 60))
+
+	// Dataset info
+	datasetIcon := phaseIcon(graph.Dataset.Phase)
+	fmt.Fprintf(w, "\n%s Dataset: %s (%s)\n", datasetIcon, graph.Dataset.Name, graph.Dataset.Phase)
+	if graph.Dataset.UfsTotal != "" {
+		fmt.Fprintf(w, "   📁 UFS Total: %s", graph.Dataset.UfsTotal)
+		if graph.Dataset.Cached != "" {
+			fmt.Fprintf(w, " | Cached: %s (%s)", graph.Dataset.Cached, graph.Dataset.CachedPercentage)
+		}
+		fmt.Fprintln(w)
+	}
+	fmt.Fprintf(w, "   🔐 Access: %s\n", accessLine(graph.Dataset))
+	for _, mount := range graph.Dataset.Mounts {
+		fmt.Fprintf(w, "   🔗 Mount: %s\n", mountLine(mount))
+	}
+	printRecentConditions(w, "   ", graph.Dataset.Conditions, graph.Metadata.MappedAt)
+	for _, op := range graph.GetResourcesByComponent(types.ComponentOperation) {
+		fmt.Fprintf(w, "   %s %s: %s (%s)\n", resourceIcon(op), op.Kind, op.Name+terminatingSuffix(op), op.Status.Phase)
+	}
+
+	// Runtime info
+	if bound := runtimes(graph); len(bound) > 0 {
+		for i, runtime := range bound {
+			printRuntimeBranch(w, graph, runtime, i == len(bound)-1)
+		}
+	} else {
+		fmt.Fprintf(w, "│\n└── ⚠ No Runtime bound\n")
+	}
+
+	if *groupByNode {
+		printPodsByNode(w, graph)
+	}
+
+	// Print warnings
+	if len(graph.Warnings) > 0 {
+		fmt.Fprintf(w, "\n%s\n", strings.Repeat("─", 60))
+		fmt.Fprintf(w, "⚠️  Warnings (%d)\n", len(graph.Warnings))
+		fmt.Fprintln(w, strings.Repeat("─", 60))
+		for _, warning := range graph.Warnings {
+			fmt.Fprintf(w, "%s [%s] %s\n", warning.Level.StatusIcon(), warning.Code, warning.Message)
+			if warning.Suggestion != "" {
+				fmt.Fprintf(w, "   💡 %s\n", warning.Suggestion)
+			}
+		}
+	}
+
+	// Print summary
+	fmt.Fprintf(w, "\n%s\n", strings.Repeat("─", 60))
+	score, grade := graph.HealthScore()
+	fmt.Fprintf(w, "📈 Summary: %d resources mapped in %s%s | %s | Health: %d (%s)\n", len(graph.Resources), graph.Metadata.Duration, mappedAgo(graph), readinessLine(graph), score, grade)
+	if graph.IsHealthy() {
+		fmt.Fprintln(w, "✅ Status: HEALTHY")
+	} else {
+		fmt.Fprintln(w, "❌ Status: UNHEALTHY")
+	}
+	fmt.Fprintln(w, strings.Repeat("─", 60))
+	return nil
+}
+
+// outputWide renders the tree followed by a detailed resource table
+func outputWide(w io.Writer, graph *types.ResourceGraph) error {
+	if err := outputTree(w, graph); err != nil {
+		return err
+	}
+	fmt.Fprintln(w, "\n📋 Detailed Resource List:")
+	fmt.Fprintln(w, strings.Repeat("─", 100))
+	fmt.Fprintf(w, "%-20s %-30s %-15s %-10s %-15s %s\n", "KIND", "NAME", "COMPONENT", "STATUS", "AGE", "OWNER")
+	fmt.Fprintln(w, strings.Repeat("─", 100))
+	for _, r := range graph.Resources {
+		fmt.Fprintf(w, "%-20s %-30s %-15s %-10s %-15s %s\n",
+			r.Kind,
+			truncate(r.Name, 28),
+			r.Component,
+			r.Status.Ready,
+			r.Status.Age,
+			r.Owner.String(),
+		)
+	}
+	fmt.Fprintln(w, strings.Repeat("─", 100))
+	return nil
+}
+
+func printPodChildren(w io.Writer, children []types.K8sResourceNode, indent string) {
+	for i, pod := range children {
+		prefix := indent + "   ├──"
+		if i == len(children)-1 {
+			prefix = indent + "   └──"
+		}
+		icon := "🟢"
+		if pod.Status.Phase != types.PhaseReady && string(pod.Status.Phase) != "Running" {
+			icon = "🟡"
+			if pod.Status.Phase == types.PhaseFailed {
+				icon = "🔴"
+			}
+		}
+		if pod.Terminating {
+			icon = terminatingIcon
+		}
+		fmt.Fprintf(w, "%s %s Pod: %s (%s)%s%s\n", prefix, icon, pod.Name, pod.Status.Message, usageSuffix(pod)+imageSuffix(pod), terminatingSuffix(pod))
+
+		eventIndent := indent + "   │   "
+		if i == len(children)-1 {
+			eventIndent = indent + "       "
+		}
+		for _, event := range pod.Events {
+			fmt.Fprintf(w, "%s⚡ %s\n", eventIndent, event)
+		}
+	}
+}
+
+// outputExplain renders the ranked root-cause summary, as text or as a JSON
+// array of explanations with -o json
+func outputExplain(w io.Writer, graph *types.ResourceGraph) error {
+	if *outputFormat == "json" {
+		explanations := graph.Explanations()
+		if explanations == nil {
+			explanations = []types.Explanation{}
+		}
+		data, err := json.MarshalIndent(explanations, "", "  ")
+		if err != nil {
+			return fmt.Errorf("failed to marshal JSON: %w", err)
+		}
+		_, err = fmt.Fprintln(w, string(data))
+		return err
+	}
+
+	_, err := io.WriteString(w, graph.Explain())
+	return err
+}
+
+// outputWarnings renders only the warnings at or above --min-level, most
+// severe first, as text or as a JSON array with -o json
+func outputWarnings(w io.Writer, graph *types.ResourceGraph) error {
+	warnings := graph.WarningsAtLeast(types.WarningLevel(*minLevel))
+
+	if *outputFormat == "json" {
+		if warnings == nil {
+			warnings = []types.MappingWarning{}
+		}
+		data, err := json.MarshalIndent(warnings, "", "  ")
+		if err != nil {
+			return fmt.Errorf("failed to marshal JSON: %w", err)
+		}
+		_, err = fmt.Fprintln(w, string(data))
+		return err
+	}
+
+	if len(warnings) == 0 {
+		_, err := fmt.Fprintf(w, "✅ No warnings at level %s or above\n", *minLevel)
+		return err
+	}
+	for _, warning := range warnings {
+		line := fmt.Sprintf("%s [%s] %s", warning.Level.StatusIcon(), warning.Code, warning.Message)
+		if warning.Resource != "" {
+			line += " (" + warning.Resource + ")"
+		}
+		fmt.Fprintln(w, line)
+		if warning.Suggestion != "" {
+			fmt.Fprintf(w, "   💡 %s\n", warning.Suggestion)
+		}
+	}
+	return nil
+}
+
+// validLevel reports whether level names a warning level
+func validLevel(level string) bool {
+	return types.WarningLevel(level).Severity() > 0
+}
+
+// storageSuffix shows a volume's capacity and access modes, e.g. " (100Gi, ReadOnlyMany)",
+// or a StorageClass's provisioner and binding mode
+func storageSuffix(r types.K8sResourceNode) string {
+	var parts []string
+	if provisioner := r.Details["provisioner"]; provisioner != "" {
+		parts = append(parts, provisioner)
+	}
+	if mode := r.Details["volumeBindingMode"]; mode != "" {
+		parts = append(parts, mode)
+	}
+	if capacity := r.Details["capacity"]; capacity != "" {
+		parts = append(parts, capacity)
+	}
+	if modes := r.Details["accessModes"]; modes != "" {
+		parts = append(parts, modes)
+	}
+	if len(parts) == 0 {
+		return ""
+	}
+	return " (" + strings.Join(parts, ", ") + ")"
+}
+
+// replicaRangeSuffix shows an autoscaler's replica bounds, e.g. " (min 2, max 4)"
+func replicaRangeSuffix(r types.K8sResourceNode) string {
+	minReplicas, maxReplicas := r.Details["minReplicas"], r.Details["maxReplicas"]
+	if minReplicas == "" || maxReplicas == "" {
+		return ""
+	}
+	return fmt.Sprintf(" (min %s, max %s)", minReplicas, maxReplicas)
+}
+
+// accessLine formats the Dataset's access modes and shared mount options,
+// e.g. "ReadOnlyMany | Shared options: a=b"
+func accessLine(dataset types.DatasetNode) string {
+	line := strings.Join(dataset.AccessModes, ", ")
+	if line == "" {
+		line = "ReadOnlyMany (default)"
+	}
+	if len(dataset.SharedOptions) > 0 {
+		options := make([]string, 0, len(dataset.SharedOptions))
+		for k, v := range dataset.SharedOptions {
+			options = append(options, k+"="+v)
+		}
+		sort.Strings(options)
+		line += " | Shared options: " + strings.Join(options, ", ")
+	}
+	return line
+}
+
+// mountLine formats a mount as "name: uri → path", leaving out unset parts
+func mountLine(mount types.MountPoint) string {
+	line := mount.MountPoint
+	if mount.Name != "" {
+		line = mount.Name + ": " + line
+	}
+	if mount.Path != "" {
+		line += " → " + mount.Path
+	}
+	return line
+}
+
+// printPodsByNode lists worker and fuse pods grouped by the node they run on,
+// flagging nodes where the two are not colocated
+func printPodsByNode(w io.Writer, graph *types.ResourceGraph) {
+	byNode := make(map[string][]types.K8sResourceNode)
+	for _, r := range graph.Resources {
+		for _, pod := range r.Children {
+			if pod.Kind != "Pod" || (pod.Component != types.ComponentWorker && pod.Component != types.ComponentFuse) {
+				continue
+			}
+			node := pod.Node
+			if node == "" {
+				node = "(unscheduled)"
+			}
+			byNode[node] = append(byNode[node], pod)
+		}
+	}
+	if len(byNode) == 0 {
+		return
+	}
+
+	nodes := make([]string, 0, len(byNode))
+	for node := range byNode {
+		nodes = append(nodes, node)
+	}
+	sort.Strings(nodes)
+
+	fmt.Fprintf(w, "\n🖥 Pods by Node\n")
+	for i, node := range nodes {
+		connector, indent := "├──", "│   "
+		if i == len(nodes)-1 {
+			connector, indent = "└──", "    "
+		}
+
+		pods := byNode[node]
+		var hasWorker, hasFuse bool
+		for _, pod := range pods {
+			hasWorker = hasWorker || pod.Component == types.ComponentWorker
+			hasFuse = hasFuse || pod.Component == types.ComponentFuse
+		}
+		note := ""
+		switch {
+		case node == "(unscheduled)":
+		case hasFuse && !hasWorker:
+			note = " ⚠ no local worker, fuse reads miss the local cache"
+		case hasWorker && !hasFuse:
+			note = " ⚠ no fuse pod, workloads here cannot mount the dataset"
+		}
+		fmt.Fprintf(w, "%s %s%s\n", connector, node, note)
+
+		for j, pod := range pods {
+			prefix := indent + "├──"
+			if j == len(pods)-1 {
+				prefix = indent + "└──"
+			}
+			fmt.Fprintf(w, "%s %s %s: %s (%s)\n", prefix, resourceIcon(pod), pod.Component, pod.Name, pod.Status.Message)
+		}
+	}
+}
+
+// usageSuffix shows a pod's live usage against its limits, e.g.
+// " [cpu 640m/2, mem 3650Mi/4Gi]", when usage was collected with --metrics
+func usageSuffix(pod types.K8sResourceNode) string {
+	var parts []string
+	for _, r := range []struct{ name, usage, limit string }{
+		{"cpu", "cpuUsage", "cpuLimit"},
+		{"mem", "memoryUsage", "memoryLimit"},
+	} {
+		usage, ok := pod.Details[r.usage]
+		if !ok {
+			continue
+		}
+		if limit, ok := pod.Details[r.limit]; ok {
+			usage += "/" + limit
+		}
+		parts = append(parts, r.name+" "+usage)
+	}
+	if len(parts) == 0 {
+		return ""
+	}
+	return " [" + strings.Join(parts, ", ") + "]"
+}
+
+// printRuntimeBranch prints a runtime and its resources as a branch of the tree
+func printRuntimeBranch(w io.Writer, graph *types.ResourceGraph, runtime *types.RuntimeNode, last bool) {
+	connector, indent := "├──", "│   "
+	if last {
+		connector, indent = "└──", "    "
+	}
+
+	fmt.Fprintf(w, "│\n%s 🔧 Runtime: %s (%s)\n", connector, runtime.Name, runtime.Type)
+	if status := runtimeStatusLine(runtime); status != "" {
+		fmt.Fprintf(w, "%s│   %s\n", indent, status)
+	}
+	if runtime.Cache != nil {
+		fmt.Fprintf(w, "%s│   💾 Cache: %s\n", indent, cacheLine(runtime.Cache))
+	}
+	if runtime.Profile != nil {
+		fmt.Fprintf(w, "%s│   📋 Profile: %s\n", indent, profileLine(runtime.Profile))
+	}
+	printRecentConditions(w, indent+"│   ", runtime.Conditions, graph.Metadata.MappedAt)
+	for _, c := range graph.GetResourcesByComponent(types.ComponentController) {
+		if c.Details["runtimeType"] == string(runtime.Type) {
+			fmt.Fprintf(w, "%s│   %s Controller: %s/%s %s%s\n", indent, resourceIcon(c), c.Namespace, c.Name+terminatingSuffix(c), colorReady(c.Status.Ready), podCountSuffix(c)+imageSuffix(c))
+			printPodChildren(w, c.Children, indent+"│  ")
+		}
+	}
+
+	// Group the runtime's resources by component. A lone runtime owns every
+	// resource, which also covers graphs produced before runtimes were tagged.
+	sub := graph
+	if len(runtimes(graph)) > 1 {
+		sub = &types.ResourceGraph{Resources: graph.GetResourcesByRuntime(runtime.Name)}
+	}
+	masters := sub.GetResourcesByComponent(types.ComponentMaster)
+	workers := sub.GetResourcesByComponent(types.ComponentWorker)
+	fuses := sub.GetResourcesByComponent(types.ComponentFuse)
+	storage := sub.GetResourcesByComponent(types.ComponentStorage)
+	configs := sub.GetResourcesByComponent(types.ComponentConfig)
+	expected := mapper.GetRuntimeComponents(runtime.Type)
+
+	// Print Master
+	if len(masters) > 0 {
+		for i, r := range masters {
+			prefix := indent + "├──"
+			if i == len(masters)-1 && len(workers) == 0 && len(fuses) == 0 && len(storage) == 0 {
+				prefix = indent + "└──"
+			}
+			fmt.Fprintf(w, "%s %s %s: %s %s%s\n", prefix, resourceIcon(r), r.Kind, r.Name+terminatingSuffix(r), colorReady(r.Status.Ready), podCountSuffix(r)+imageSuffix(r))
+			printPodChildren(w, r.Children, indent+"│")
+		}
+	} else if expected.HasMaster && runtime.MasterPhase != "" && componentShown(types.ComponentMaster) {
+		fmt.Fprintf(w, "%s├── ✗ Master: MISSING\n", indent)
+	}
+
+	// Print Workers
+	if len(workers) > 0 {
+		for i, r := range workers {
+			prefix := indent + "├──"
+			if i == len(workers)-1 && len(fuses) == 0 && len(storage) == 0 {
+				prefix = indent + "└──"
+			}
+			fmt.Fprintf(w, "%s %s %s: %s %s%s%s\n", prefix, resourceIcon(r), r.Kind, r.Name+terminatingSuffix(r), colorReady(r.Status.Ready), replicaRangeSuffix(r), podCountSuffix(r)+imageSuffix(r))
+			printPodChildren(w, r.Children, indent+"│")
+		}
+	} else if expected.HasWorker && componentShown(types.ComponentWorker) {
+		fmt.Fprintf(w, "%s├── ✗ Worker: MISSING\n", indent)
+	}
+
+	// Print Fuse
+	if len(fuses) > 0 {
+		for i, r := range fuses {
+			prefix := indent + "├──"
+			if i == len(fuses)-1 && len(storage) == 0 && len(configs) == 0 {
+				prefix = indent + "└──"
+			}
+			fmt.Fprintf(w, "%s %s %s: %s %s%s\n", prefix, resourceIcon(r), r.Kind, r.Name+terminatingSuffix(r), colorReady(r.Status.Ready), podCountSuffix(r)+imageSuffix(r))
+		}
+	} else if runtime.FuseMode == types.FuseModeSidecar && componentShown(types.ComponentFuse) {
+		fmt.Fprintf(w, "%s├── ✓ Fuse: Sidecar in %s\n", indent, sidecarList(runtime.FuseSidecars))
+	} else if expected.HasFuse && componentShown(types.ComponentFuse) {
+		fmt.Fprintf(w, "%s├── ⚠ Fuse: Not deployed (on-demand)\n", indent)
+	}
+
+	// Print Storage
+	if len(storage) > 0 {
+		fmt.Fprintf(w, "%s│\n", indent)
+		fmt.Fprintf(w, "%s├── 💾 Storage\n", indent)
+		for i, r := range storage {
+			prefix := indent + "│   ├──"
+			if i == len(storage)-1 && len(configs) == 0 {
+				prefix = indent + "│   └──"
+			}
+			fmt.Fprintf(w, "%s %s %s: %s%s\n", prefix, resourceIcon(r), r.Kind, r.Name, storageSuffix(r)+terminatingSuffix(r))
+			childIndent := indent + "│   │"
+			if i == len(storage)-1 && len(configs) == 0 {
+				childIndent = indent + "│    "
+			}
+			printPodChildren(w, r.Children, childIndent)
+		}
+	}
+
+	// Print Configs
+	if len(configs) > 0 {
+		fmt.Fprintf(w, "%s│\n", indent)
+		fmt.Fprintf(w, "%s└── ⚙️  Configuration\n", indent)
+		for i, r := range configs {
+			prefix := indent + "    ├──"
+			if i == len(configs)-1 {
+				prefix = indent + "    └──"
+			}
+			fmt.Fprintf(w, "%s %s %s: %s\n", prefix, resourceIcon(r), r.Kind, r.Name+terminatingSuffix(r))
+		}
+	}
+}
+
+// podCountSuffix shows the pods of a workload collapsed by --pods=count,
+// e.g. " [47/50 pods ready]"
+func podCountSuffix(r types.K8sResourceNode) string {
+	count, ok := r.Details["podCount"]
+	if !ok {
+		return ""
+	}
+	return fmt.Sprintf(" [%s/%s pods ready]", r.Details["podsReady"], count)
+}
+
+// imageSuffix shows the container images of a workload or pod with
+// --show-images, e.g. " [alluxio/alluxio:2.9.0]"
+func imageSuffix(r types.K8sResourceNode) string {
+	if !*showImages || r.Details["image"] == "" {
+		return ""
+	}
+	return " [" + r.Details["image"] + "]"
+}
+
+// runtimes returns the runtimes bound in the graph, falling back to the single
+// Runtime field for graphs that predate multi-runtime support
+func runtimes(graph *types.ResourceGraph) []*types.RuntimeNode {
+	if len(graph.Runtimes) == 0 && graph.Runtime != nil {
+		return []*types.RuntimeNode{graph.Runtime}
+	}
+	return graph.Runtimes
+}
+
+// mappedAgo is ", mapped 3m0s ago" for a graph mapped at least a second ago,
+// e.g. one served from a cache, and empty for a fresh mapping
+func mappedAgo(graph *types.ResourceGraph) string {
+	age := graph.Age().Round(time.Second)
+	if age < time.Second {
+		return ""
+	}
+	return fmt.Sprintf(", mapped %s ago", age)
+}
+
+// sidecarList names the pods running a fuse sidecar, e.g. "2 pods (app-0, app-1)"
+func sidecarList(pods []string) string {
+	noun := "pods"
+	if len(pods) == 1 {
+		noun = "pod"
+	}
+	return fmt.Sprintf("%d %s (%s)", len(pods), noun, strings.Join(pods, ", "))
+}
+
+// profileLine formats a ThinRuntimeProfile as "name (fileSystemType, fuse image)",
+// or marks it missing
+func profileLine(profile *types.ThinRuntimeProfile) string {
+	if !profile.Found {
+		return profile.Name + " ✗ MISSING"
+	}
+	var parts []string
+	if profile.FileSystemType != "" {
+		parts = append(parts, profile.FileSystemType)
+	}
+	if profile.FuseImage != "" {
+		parts = append(parts, "fuse "+profile.FuseImage)
+	}
+	if len(parts) == 0 {
+		return profile.Name
+	}
+	return profile.Name + " (" + strings.Join(parts, ", ") + ")"
+}
+
+// printRecentConditions lists the conditions that transitioned within
+// --recent-conditions before the graph was mapped, newest first, e.g.
+// "🔄 Ready: False (MountFailed), changed 42s ago"
+func printRecentConditions(w io.Writer, indent string, conditions []types.ConditionBrief, now time.Time) {
+	if *recentConds <= 0 {
+		return
+	}
+
+	var recent []types.ConditionBrief
+	for _, c := range conditions {
+		if c.TransitionedWithin(*recentConds, now) {
+			recent = append(recent, c)
+		}
+	}
+	sort.SliceStable(recent, func(i, j int) bool {
+		a, _ := recent[i].Age(now)
+		b, _ := recent[j].Age(now)
+		return a < b
+	})
+
+	for _, c := range recent {
+		age, _ := c.Age(now)
+		line := c.Type + ": " + c.Status
+		if c.Reason != "" {
+			line += " (" + c.Reason + ")"
+		}
+		fmt.Fprintf(w, "%s🔄 %s, changed %s ago\n", indent, line, age.Round(time.Second))
+	}
+}
+
+// runtimeStatusLine summarizes the component phases reported by the Runtime,
+// e.g. "Master 1/1 Ready | Worker 2/3 PartialReady | Fuse 5/5 Ready"
+func runtimeStatusLine(runtime *types.RuntimeNode) string {
+	components := []struct {
+		name  string
+		ready string
+		phase string
+	}{
+		{"Master", runtime.MasterReady, runtime.MasterPhase},
+		{"Worker", runtime.WorkerReady, runtime.WorkerPhase},
+		{"Fuse", runtime.FuseReady, runtime.FusePhase},
+	}
+
+	var parts []string
+	for _, c := range components {
+		if c.ready == "" && c.phase == "" {
+			continue
+		}
+		part := c.name
+		if c.ready != "" {
+			part += " " + c.ready
+		}
+		if c.phase != "" {
+			part += " " + c.phase
+		}
+		parts = append(parts, part)
+	}
+	return strings.Join(parts, " | ")
+}
+
+// cacheLine summarizes a runtime's cache, e.g.
+// "25.00GiB / 44Gi (56.8%) over 2 workers | MEM 4Gi, SSD 40Gi"
+func cacheLine(cache *types.CacheSummary) string {
+	used, capacity := cache.Used, cache.Capacity
+	if used == "" {
+		used = "-"
+	}
+	if capacity == "" {
+		capacity = "-"
+	}
+	line := used + " / " + capacity
+	if cache.Percentage != "" {
+		line += " (" + cache.Percentage + ")"
+	}
+	if cache.Workers > 0 {
+		line += " over " + plural(int(cache.Workers), "worker")
+	}
+
+	var tiers []string
+	for _, tier := range cache.Tiers {
+		if tier.Capacity != "" {
+			tiers = append(tiers, tier.Medium+" "+tier.Capacity)
+		}
+	}
+	if len(tiers) > 0 {
+		line += " | " + strings.Join(tiers, ", ")
+	}
+	return line
+}
+
+// terminatingIcon marks resources with a deletion timestamp
+const terminatingIcon = "🗑"
+
+// resourceIcon returns the status icon of a resource, flagging terminating ones
+func resourceIcon(r types.K8sResourceNode) string {
+	if r.Terminating {
+		return terminatingIcon
+	}
+	return r.Status.Phase.StatusIcon()
+}
+
+// terminatingSuffix annotates a terminating resource with when its deletion was requested
+func terminatingSuffix(r types.K8sResourceNode) string {
+	if !r.Terminating {
+		return ""
+	}
+	return fmt.Sprintf(" [Terminating since %s]", r.DeletionTimestamp)
+}
+
+func phaseIcon(phase string) string {
+	switch phase {
+	case "Bound", "Ready":
+		return "✓"
+	case "NotBound", "NotReady", "Pending":
+		return "⚠"
+	case "Failed":
+		return "✗"
+	default:
+		return "?"
+	}
+}
+
+func colorReady(ready string) string {
+	if ready == "" {
+		return ""
+	}
+	return fmt.Sprintf("(%s)", ready)
+}
+
+func truncate(s string, max int) string {
+	if len(s) <= max {
+		return s
+	}
+	return s[:max-2] + ".."
+}
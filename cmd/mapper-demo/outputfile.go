@@ -0,0 +1,80 @@
+// Package main --output-file handling
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// fileOutput writes the rendered output to a temporary file next to the
+// --output-file path and renames it into place on exit. A run that renders
+// nothing or fails to render, e.g. on a mistyped flag or an unreachable
+// cluster, removes the temporary file and leaves an earlier result untouched.
+type fileOutput struct {
+	path    string
+	tmp     *os.File
+	written int
+	failed  bool
+}
+
+// pendingOutput is the --output-file being written, nil when writing to stdout
+var pendingOutput *fileOutput
+
+// openOutputFile creates the temporary file in the target's directory, so a
+// path that cannot be written is reported before mapping
+func openOutputFile(path string) (*fileOutput, error) {
+	tmp, err := os.CreateTemp(filepath.Dir(path), "."+filepath.Base(path)+".tmp-*")
+	if err != nil {
+		return nil, err
+	}
+	return &fileOutput{path: path, tmp: tmp}, nil
+}
+
+func (o *fileOutput) Write(b []byte) (int, error) {
+	n, err := o.tmp.Write(b)
+	o.written += n
+	if err != nil {
+		o.failed = true
+	}
+	return n, err
+}
+
+// commit renames the temporary file over the target if output was rendered
+// without failure, and removes it otherwise
+func (o *fileOutput) commit() error {
+	closeErr := o.tmp.Close()
+	if o.written == 0 || o.failed || closeErr != nil {
+		os.Remove(o.tmp.Name())
+		return closeErr
+	}
+	if err := os.Chmod(o.tmp.Name(), 0o644); err != nil {
+		os.Remove(o.tmp.Name())
+		return err
+	}
+	if err := os.Rename(o.tmp.Name(), o.path); err != nil {
+		os.Remove(o.tmp.Name())
+		return err
+	}
+	return nil
+}
+
+// renderFailed reports a rendering error and keeps a partially written
+// --output-file from replacing the previous one
+func renderFailed(err error) {
+	fmt.Fprintf(os.Stderr, "❌ Failed to render output: %v\n", err)
+	if pendingOutput != nil {
+		pendingOutput.failed = true
+	}
+}
+
+// exit finishes the --output-file, if any, and exits with code
+func exit(code int) {
+	if pendingOutput != nil {
+		if err := pendingOutput.commit(); err != nil {
+			fmt.Fprintf(os.Stderr, "❌ Failed to write output file: %v\n", err)
+			code = max(code, 1)
+		}
+	}
+	os.Exit(code)
+}
@@ -0,0 +1,86 @@
+// Package main plain ASCII output
+package main
+
+import (
+	"io"
+	"os"
+	"strings"
+)
+
+// plainReplacer substitutes ASCII tokens for the emoji, status icons and
+// box-drawing characters used by the human-readable renderers. Icons with a
+// variation selector are listed before their bare form so they match first.
+var plainReplacer = strings.NewReplacer(
+	// Status icons
+	"✓", "[OK]",
+	"🟢", "[OK]",
+	"✅", "[OK]",
+	"⚠️", "[WARN]",
+	"⚠", "[WARN]",
+	"🟡", "[WARN]",
+	"✗", "[FAIL]",
+	"🔴", "[FAIL]",
+	"❌", "[FAIL]",
+	"ℹ️", "[INFO]",
+	"⟳", "[RUN]",
+	"🗑", "[DEL]",
+
+	// Decorations
+	"📊 ", "",
+	"📁 ", "",
+	"🔧 ", "",
+	"💾 ", "",
+	"⚙️  ", "",
+	"📈 ", "",
+	"📋 ", "",
+	"💡 ", "Hint: ",
+	"⚡ ", "Event: ",
+	"🔄 ", "",
+	"🔗 ", "",
+	"🔐 ", "",
+	"🖥 ", "",
+	"🌐 ", "",
+	"📨 ", "",
+	"→", "->",
+
+	// Box drawing
+	"├──", "|--",
+	"└──", "`--",
+	"│", "|",
+	"─", "-",
+)
+
+// plainWriter rewrites everything written through it with plainReplacer
+type plainWriter struct {
+	w io.Writer
+}
+
+// Write translates p to ASCII before writing it. The renderers emit whole
+// formatted strings per call, so icons are never split across writes.
+func (p plainWriter) Write(b []byte) (int, error) {
+	if _, err := io.WriteString(p.w, plainReplacer.Replace(string(b))); err != nil {
+		return 0, err
+	}
+	return len(b), nil
+}
+
+// stdout receives the rendered output: os.Stdout, or the --output-file
+var stdout io.Writer = os.Stdout
+
+// useColor reports whether emoji output is wanted: the output must be a
+// terminal and neither --no-color nor the NO_COLOR environment variable may be set
+func useColor() bool {
+	if *noColor || os.Getenv("NO_COLOR") != "" {
+		return false
+	}
+	f, ok := stdout.(*os.File)
+	return ok && isTerminal(f)
+}
+
+// outputWriter returns the output, translated to plain ASCII unless color is wanted
+func outputWriter() io.Writer {
+	if useColor() {
+		return stdout
+	}
+	return plainWriter{w: stdout}
+}
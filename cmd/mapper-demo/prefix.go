@@ -0,0 +1,92 @@
+// Package main mapping every Dataset sharing a name prefix
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/fluid-cloudnative/fluid-resource-mapper/pkg/mapper"
+	"github.com/fluid-cloudnative/fluid-resource-mapper/pkg/types"
+)
+
+// mapByPrefix maps every Dataset in the target namespace whose name starts
+// with prefix and renders the graphs keyed by namespace/name, like
+// --contexts does per cluster. The exit code is the highest of the
+// per-dataset codes, or exitDatasetNotFound when nothing matches.
+func mapByPrefix(prefix string, opts mapper.Options) {
+	if *watch || *metricsAddr != "" || *kubeContexts != "" {
+		fmt.Fprintln(os.Stderr, "❌ --prefix cannot be used with --watch, --metrics-addr or --contexts")
+		exit(1)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), *timeout)
+	defer cancel()
+
+	m := mapper.New(newClient()).WithLogger(newLogger())
+	datasets, err := m.ListDatasets(ctx, targetNamespace())
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "❌ Failed to list datasets: %v\n", err)
+		exit(exitCodeFor(err))
+	}
+
+	matches := matchPrefix(datasets, prefix)
+	if len(matches) == 0 {
+		err := fmt.Errorf("%w: no Dataset name starts with %q", mapper.ErrDatasetNotFound, prefix)
+		fmt.Fprintf(os.Stderr, "❌ Mapping failed: %v\n", err)
+		exit(exitCodeFor(err))
+	}
+
+	graphs := make(map[string]*types.ResourceGraph)
+	var failures []string
+	code := exitOK
+	progress := startProgress()
+	for _, dataset := range matches {
+		key := dataset.Namespace + "/" + dataset.Name
+		progress.Update("Mapping " + key)
+		graph, err := m.MapFromDataset(ctx, dataset.Name, dataset.Namespace, opts)
+		if err != nil {
+			failures = append(failures, fmt.Sprintf("❌ Mapping %s failed: %v", key, err))
+			code = max(code, exitCodeFor(err))
+		}
+		if graph == nil {
+			continue
+		}
+		if !graph.IsHealthy() {
+			code = max(code, exitUnhealthy)
+		}
+		graphs[key] = filterGraph(graph)
+		sendAlert(graph)
+	}
+	progress.Stop()
+	for _, failure := range failures {
+		fmt.Fprintln(os.Stderr, failure)
+	}
+
+	if err := renderGraphs(graphs, writeDatasetHeader); err != nil {
+		renderFailed(err)
+		exit(1)
+	}
+	exit(code)
+}
+
+// matchPrefix returns the datasets whose name starts with prefix, in list order
+func matchPrefix(datasets []types.DatasetNode, prefix string) []types.DatasetNode {
+	var matches []types.DatasetNode
+	for _, dataset := range datasets {
+		if strings.HasPrefix(dataset.Name, prefix) {
+			matches = append(matches, dataset)
+		}
+	}
+	return matches
+}
+
+// writeDatasetHeader separates the datasets in the human-readable formats
+func writeDatasetHeader(w io.Writer, key string, gap bool) {
+	if gap {
+		fmt.Fprintln(w)
+	}
+	fmt.Fprintf(w, "📁 Dataset: %s\n", key)
+}
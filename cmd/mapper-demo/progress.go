@@ -0,0 +1,91 @@
+// Package main terminal progress indicator
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"sync"
+	"time"
+)
+
+// spinnerFrames animate the progress line; asciiFrames replace them when color is disabled
+var (
+	spinnerFrames = []string{"⠋", "⠙", "⠹", "⠸", "⠼", "⠴", "⠦", "⠧", "⠇", "⠏"}
+	asciiFrames   = []string{"|", "/", "-", "\\"}
+)
+
+// spinner redraws a single stderr line with the current discovery step until
+// stopped, then erases it so nothing is left behind
+type spinner struct {
+	w      io.Writer
+	frames []string
+
+	mu   sync.Mutex
+	step string
+
+	done    chan struct{}
+	stopped sync.WaitGroup
+}
+
+// startProgress starts a spinner on stderr and returns it, or nil when stderr
+// is not a terminal, --quiet is set, or -v logs would interleave with it.
+// The nil spinner's methods do nothing.
+func startProgress() *spinner {
+	if *quiet || *verbosity > 0 || !isTerminal(os.Stderr) {
+		return nil
+	}
+
+	s := &spinner{w: os.Stderr, frames: spinnerFrames, step: "Connecting", done: make(chan struct{})}
+	if *noColor || os.Getenv("NO_COLOR") != "" {
+		s.frames = asciiFrames
+	}
+	s.stopped.Add(1)
+	go s.run()
+	return s
+}
+
+// Update sets the step shown on the next redraw
+func (s *spinner) Update(step string) {
+	if s == nil {
+		return
+	}
+	s.mu.Lock()
+	s.step = step
+	s.mu.Unlock()
+}
+
+// Stop erases the progress line and waits for the spinner to exit
+func (s *spinner) Stop() {
+	if s == nil {
+		return
+	}
+	close(s.done)
+	s.stopped.Wait()
+}
+
+func (s *spinner) run() {
+	defer s.stopped.Done()
+	ticker := time.NewTicker(100 * time.Millisecond)
+	defer ticker.Stop()
+
+	for frame := 0; ; frame++ {
+		s.mu.Lock()
+		step := s.step
+		s.mu.Unlock()
+		fmt.Fprintf(s.w, "\r\033[K%s %s...", s.frames[frame%len(s.frames)], step)
+
+		select {
+		case <-s.done:
+			fmt.Fprint(s.w, "\r\033[K")
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// isTerminal reports whether f is an interactive terminal
+func isTerminal(f *os.File) bool {
+	info, err := f.Stat()
+	return err == nil && info.Mode()&os.ModeCharDevice != 0
+}
@@ -0,0 +1,42 @@
+// Package main JSONPath queries over the mapped graph
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"k8s.io/client-go/util/jsonpath"
+
+	"github.com/fluid-cloudnative/fluid-resource-mapper/pkg/types"
+)
+
+// newQueryRenderer parses a kubectl-style JSONPath template (e.g.
+// '{.resources[*].name}') and returns a Renderer that prints only the values
+// it matches in the graph's JSON output. Missing keys render as empty rather
+// than failing, so a query can be run against graphs without optional fields.
+func newQueryRenderer(query string) (Renderer, error) {
+	parser := jsonpath.New("query").AllowMissingKeys(true)
+	if err := parser.Parse(query); err != nil {
+		return nil, fmt.Errorf("invalid --query %q: %w", query, err)
+	}
+
+	return RendererFunc(func(w io.Writer, graph *types.ResourceGraph) error {
+		// Round-trip through JSON so the template sees the same field names
+		// as -o json rather than the Go struct fields
+		data, err := json.Marshal(graph)
+		if err != nil {
+			return fmt.Errorf("failed to marshal JSON: %w", err)
+		}
+		var doc interface{}
+		if err := json.Unmarshal(data, &doc); err != nil {
+			return fmt.Errorf("failed to decode JSON: %w", err)
+		}
+
+		if err := parser.Execute(w, doc); err != nil {
+			return fmt.Errorf("failed to evaluate --query: %w", err)
+		}
+		_, err = fmt.Fprintln(w)
+		return err
+	}), nil
+}
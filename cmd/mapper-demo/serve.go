@@ -0,0 +1,171 @@
+// Package main HTTP server mode
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"os/signal"
+	"strconv"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/fluid-cloudnative/fluid-resource-mapper/pkg/mapper"
+	"github.com/fluid-cloudnative/fluid-resource-mapper/pkg/types"
+)
+
+// datasetsPath is the prefix of every route served by the serve command
+const datasetsPath = "/datasets/"
+
+// datasetServer answers REST requests by mapping on demand with a shared
+// mapper. Graphs are cached for --cache-ttl so a dashboard polling many
+// Datasets does not remap each one per request.
+type datasetServer struct {
+	mapper *mapper.Mapper
+	opts   mapper.Options
+	ttl    time.Duration
+
+	mu     sync.Mutex
+	graphs map[string]*types.ResourceGraph
+}
+
+// serveDatasets runs the serve command: GET /datasets/{namespace} lists the
+// Datasets ("all" for every namespace) and GET /datasets/{namespace}/{name}
+// returns the ResourceGraph, mapped with the same flags as the dataset
+// command and cached for --cache-ttl. It shuts down gracefully on SIGINT or
+// SIGTERM.
+func serveDatasets() {
+	if err := validateComponents(splitList(*components)); err != nil {
+		fmt.Fprintf(os.Stderr, "❌ %v\n", err)
+		exit(1)
+	}
+
+	s := &datasetServer{
+		mapper: mapper.New(newClient()).WithLogger(newLogger()),
+		opts:   mappingOptions(),
+		ttl:    *cacheTTL,
+		graphs: make(map[string]*types.ResourceGraph),
+	}
+	mux := http.NewServeMux()
+	mux.Handle(datasetsPath, s)
+	server := &http.Server{Addr: *listenAddr, Handler: mux, ReadHeaderTimeout: 10 * time.Second}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	go func() {
+		<-ctx.Done()
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), *timeout)
+		defer cancel()
+		_ = server.Shutdown(shutdownCtx)
+	}()
+
+	fmt.Fprintf(os.Stderr, "🌐 Serving datasets on %s%s\n", *listenAddr, datasetsPath)
+	if err := server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		fmt.Fprintf(os.Stderr, "❌ Server failed: %v\n", err)
+		exit(1)
+	}
+}
+
+func (s *datasetServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		w.Header().Set("Allow", http.MethodGet)
+		writeError(w, http.StatusMethodNotAllowed, fmt.Errorf("method %s not allowed", r.Method))
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), *timeout)
+	defer cancel()
+
+	parts := strings.Split(strings.Trim(strings.TrimPrefix(r.URL.Path, datasetsPath), "/"), "/")
+	switch {
+	case len(parts) == 1 && parts[0] != "":
+		namespace := parts[0]
+		if namespace == "all" {
+			namespace = mapper.AllNamespaces
+		}
+		datasets, err := s.mapper.ListDatasets(ctx, namespace)
+		if err != nil {
+			writeError(w, statusFor(err), err)
+			return
+		}
+		writeJSON(w, http.StatusOK, datasets)
+	case len(parts) == 2 && parts[0] != "" && parts[1] != "":
+		graph, err := s.graph(ctx, parts[1], parts[0])
+		if graph == nil {
+			writeError(w, statusFor(err), err)
+			return
+		}
+		// A cached graph says how old it is; one kept past the TTL because
+		// remapping failed is marked stale so nobody acts on it unawares
+		if age := graph.Age(); age >= time.Second {
+			w.Header().Set("Age", strconv.Itoa(int(age.Seconds())))
+			if age > s.ttl {
+				w.Header().Set("Warning", `110 - "Response is Stale"`)
+			}
+		}
+		// A partial graph is still returned; the failure is in its warnings
+		writeJSON(w, http.StatusOK, filterGraph(graph))
+	default:
+		writeError(w, http.StatusNotFound, fmt.Errorf("no route for %s; use %s{namespace} or %s{namespace}/{name}", r.URL.Path, datasetsPath, datasetsPath))
+	}
+}
+
+// graph returns the cached graph of the dataset while it is younger than
+// the TTL, and maps it again otherwise. When the new mapping fails outright
+// the previous graph, however old, is returned instead of the error.
+func (s *datasetServer) graph(ctx context.Context, name, namespace string) (*types.ResourceGraph, error) {
+	key := namespace + "/" + name
+	s.mu.Lock()
+	cached := s.graphs[key]
+	s.mu.Unlock()
+	if cached != nil && cached.Age() < s.ttl {
+		return cached, nil
+	}
+
+	graph, err := s.mapper.MapFromDataset(ctx, name, namespace, s.opts)
+	if graph == nil {
+		if cached != nil && !errors.Is(err, mapper.ErrDatasetNotFound) {
+			return cached, nil
+		}
+		return nil, err
+	}
+	if s.ttl > 0 {
+		s.mu.Lock()
+		s.graphs[key] = graph
+		s.mu.Unlock()
+	}
+	return graph, nil
+}
+
+// statusFor maps a mapping error to an HTTP status, like exitCodeFor does for exit codes
+func statusFor(err error) int {
+	switch {
+	case errors.Is(err, mapper.ErrDatasetNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, mapper.ErrClusterUnreachable):
+		return http.StatusBadGateway
+	case errors.Is(err, mapper.ErrAccessDenied):
+		return http.StatusForbidden
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+// writeJSON writes v as an indented JSON response
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	_ = encoder.Encode(v)
+}
+
+// writeError writes err as a {"error": "..."} JSON response
+func writeError(w http.ResponseWriter, status int, err error) {
+	writeJSON(w, status, map[string]string{"error": err.Error()})
+}
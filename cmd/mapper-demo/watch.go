@@ -0,0 +1,98 @@
+// Package main watch mode
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/fluid-cloudnative/fluid-resource-mapper/pkg/diff"
+	"github.com/fluid-cloudnative/fluid-resource-mapper/pkg/mapper"
+	"github.com/fluid-cloudnative/fluid-resource-mapper/pkg/types"
+)
+
+// clearScreen moves the cursor home and clears the terminal
+const clearScreen = "\033[H\033[2J"
+
+// watchDataset re-maps the dataset every interval until interrupted, clearing
+// the screen before each render and listing what changed since the last poll
+func watchDataset(m *mapper.Mapper, name string, opts mapper.Options) {
+	if *interval <= 0 {
+		fmt.Fprintf(os.Stderr, "❌ --interval must be positive, got %s\n", *interval)
+		exit(1)
+	}
+
+	renderer := renderers[*outputFormat]
+	if *onlyWarnings {
+		renderer = RendererFunc(outputWarnings)
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	ticker := time.NewTicker(*interval)
+	defer ticker.Stop()
+
+	out := io.Writer(os.Stdout)
+	if humanFormats[*outputFormat] || (*onlyWarnings && *outputFormat != "json") {
+		out = outputWriter()
+	}
+
+	var previous *types.ResourceGraph
+	for {
+		mapCtx, cancel := context.WithTimeout(ctx, *timeout)
+		graph, err := m.MapFromDataset(mapCtx, name, targetNamespace(), opts)
+		cancel()
+		if ctx.Err() != nil {
+			return
+		}
+
+		fmt.Fprint(os.Stdout, clearScreen)
+		if graph != nil {
+			if renderErr := renderer.Render(out, filterGraph(graph)); renderErr != nil {
+				renderFailed(renderErr)
+				exit(1)
+			}
+			if previous != nil {
+				outputChanges(out, diff.Diff(previous, graph))
+			}
+			previous = graph
+		}
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "❌ Mapping failed: %v\n", err)
+		}
+		fmt.Fprintf(os.Stderr, "Every %s, last update %s (Ctrl-C to stop)\n", *interval, time.Now().Format("15:04:05"))
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// outputChanges highlights the resources that changed since the previous poll
+func outputChanges(w io.Writer, d *diff.GraphDiff) {
+	if len(d.Added) == 0 && len(d.Removed) == 0 && len(d.Changed) == 0 {
+		return
+	}
+
+	fmt.Fprintln(w, "\n🔄 Changes since last poll:")
+	for _, r := range d.Added {
+		fmt.Fprintf(w, "   + %s %s: %s\n", r.Kind, r.Name, r.Phase)
+	}
+	for _, r := range d.Removed {
+		fmt.Fprintf(w, "   - %s %s: %s\n", r.Kind, r.Name, r.Phase)
+	}
+	for _, c := range d.Changed {
+		line := fmt.Sprintf("   ~ %s %s: %s → %s", c.Kind, c.Name, c.OldPhase, c.NewPhase)
+		if c.OldReady != c.NewReady {
+			line += fmt.Sprintf(" (%s → %s)", c.OldReady, c.NewReady)
+		}
+		fmt.Fprintln(w, line)
+	}
+}
@@ -0,0 +1,153 @@
+// Package diff compares two resource graphs produced by the mapper.
+// It is meant for before/after comparisons, e.g. around a Runtime upgrade.
+package diff
+
+import (
+	"sort"
+
+	"github.com/fluid-cloudnative/fluid-resource-mapper/pkg/types"
+)
+
+// GraphDiff describes how a resource graph changed between two mappings
+type GraphDiff struct {
+	// Added lists resources only present in the new graph
+	Added []ResourceRef `json:"added,omitempty"`
+
+	// Removed lists resources only present in the old graph
+	Removed []ResourceRef `json:"removed,omitempty"`
+
+	// Changed lists resources whose phase or readiness changed
+	Changed []ResourceChange `json:"changed,omitempty"`
+
+	// NewWarnings lists warnings only raised for the new graph
+	NewWarnings []types.MappingWarning `json:"newWarnings,omitempty"`
+
+	// ResolvedWarnings lists warnings only raised for the old graph
+	ResolvedWarnings []types.MappingWarning `json:"resolvedWarnings,omitempty"`
+}
+
+// ResourceRef identifies a resource and its status at the time it was mapped
+type ResourceRef struct {
+	Kind      string              `json:"kind"`
+	Namespace string              `json:"namespace,omitempty"`
+	Name      string              `json:"name"`
+	Phase     types.ResourcePhase `json:"phase"`
+}
+
+// ResourceChange describes a status change of a resource present in both graphs
+type ResourceChange struct {
+	Kind      string              `json:"kind"`
+	Namespace string              `json:"namespace,omitempty"`
+	Name      string              `json:"name"`
+	OldPhase  types.ResourcePhase `json:"oldPhase"`
+	NewPhase  types.ResourcePhase `json:"newPhase"`
+	OldReady  string              `json:"oldReady,omitempty"`
+	NewReady  string              `json:"newReady,omitempty"`
+}
+
+// IsEmpty returns true if both graphs are equivalent
+func (d *GraphDiff) IsEmpty() bool {
+	return len(d.Added) == 0 && len(d.Removed) == 0 && len(d.Changed) == 0 &&
+		len(d.NewWarnings) == 0 && len(d.ResolvedWarnings) == 0
+}
+
+// Diff compares two graphs. Resources, including pods nested under workloads,
+// are matched by kind, namespace and name; warnings by code and resource.
+func Diff(oldGraph, newGraph *types.ResourceGraph) *GraphDiff {
+	d := &GraphDiff{}
+
+	oldResources := indexResources(oldGraph)
+	newResources := indexResources(newGraph)
+
+	for _, key := range sortedKeys(newResources) {
+		r := newResources[key]
+		prev, ok := oldResources[key]
+		if !ok {
+			d.Added = append(d.Added, refOf(r))
+			continue
+		}
+		if prev.Status.Phase != r.Status.Phase || prev.Status.Ready != r.Status.Ready {
+			d.Changed = append(d.Changed, ResourceChange{
+				Kind:      r.Kind,
+				Namespace: r.Namespace,
+				Name:      r.Name,
+				OldPhase:  prev.Status.Phase,
+				NewPhase:  r.Status.Phase,
+				OldReady:  prev.Status.Ready,
+				NewReady:  r.Status.Ready,
+			})
+		}
+	}
+
+	for _, key := range sortedKeys(oldResources) {
+		if _, ok := newResources[key]; !ok {
+			d.Removed = append(d.Removed, refOf(oldResources[key]))
+		}
+	}
+
+	oldWarnings := indexWarnings(oldGraph)
+	newWarnings := indexWarnings(newGraph)
+
+	for _, key := range sortedKeys(newWarnings) {
+		if _, ok := oldWarnings[key]; !ok {
+			d.NewWarnings = append(d.NewWarnings, newWarnings[key])
+		}
+	}
+	for _, key := range sortedKeys(oldWarnings) {
+		if _, ok := newWarnings[key]; !ok {
+			d.ResolvedWarnings = append(d.ResolvedWarnings, oldWarnings[key])
+		}
+	}
+
+	return d
+}
+
+// indexResources flattens the graph's resources and their children by kind/namespace/name
+func indexResources(graph *types.ResourceGraph) map[string]types.K8sResourceNode {
+	index := make(map[string]types.K8sResourceNode)
+	if graph == nil {
+		return index
+	}
+
+	var add func(nodes []types.K8sResourceNode)
+	add = func(nodes []types.K8sResourceNode) {
+		for _, r := range nodes {
+			index[r.Kind+"/"+r.Namespace+"/"+r.Name] = r
+			add(r.Children)
+		}
+	}
+	add(graph.Resources)
+	return index
+}
+
+// indexWarnings keys the graph's warnings by code and resource
+func indexWarnings(graph *types.ResourceGraph) map[string]types.MappingWarning {
+	index := make(map[string]types.MappingWarning)
+	if graph == nil {
+		return index
+	}
+
+	for _, w := range graph.Warnings {
+		index[w.Code+"/"+w.Resource] = w
+	}
+	return index
+}
+
+// sortedKeys returns the keys of an index in a stable order
+func sortedKeys[T any](index map[string]T) []string {
+	keys := make([]string, 0, len(index))
+	for k := range index {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func refOf(r types.K8sResourceNode) ResourceRef {
+	return ResourceRef{
+		Kind:      r.Kind,
+		Namespace: r.Namespace,
+		Name:      r.Name,
+		Phase:     r.Status.Phase,
+	}
+}
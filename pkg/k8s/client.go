@@ -5,19 +5,33 @@ package k8s
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/url"
 	"os"
 	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
 
 	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
 	corev1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+	"k8s.io/client-go/util/flowcontrol"
 )
 
 // FluidAPI group and version constants
@@ -45,8 +59,22 @@ var (
 	VineyardRuntimeGVR = FluidGVR("vineyardruntimes")
 	EFCRuntimeGVR      = FluidGVR("efcruntimes")
 	ThinRuntimeGVR     = FluidGVR("thinruntimes")
+	DataLoadGVR        = FluidGVR("dataloads")
+	DataBackupGVR      = FluidGVR("databackups")
+	DataMigrateGVR     = FluidGVR("datamigrates")
 )
 
+// ThinRuntimeProfileGVR is the cluster-scoped profile a ThinRuntime
+// references by spec.profileName
+var ThinRuntimeProfileGVR = FluidGVR("thinruntimeprofiles")
+
+// PodMetricsGVR is the metrics-server resource reporting live pod usage
+var PodMetricsGVR = schema.GroupVersionResource{
+	Group:    "metrics.k8s.io",
+	Version:  "v1beta1",
+	Resource: "pods",
+}
+
 // RuntimeTypeToGVR maps runtime type strings to their GVRs
 var RuntimeTypeToGVR = map[string]schema.GroupVersionResource{
 	"alluxio":  AlluxioRuntimeGVR,
@@ -67,21 +95,51 @@ type Client interface {
 
 	// Runtime operations
 	GetRuntime(ctx context.Context, runtimeType, name, namespace string) (*unstructured.Unstructured, error)
+	GetThinRuntimeProfile(ctx context.Context, name string) (*unstructured.Unstructured, error)
 
 	// Workload operations
 	ListStatefulSets(ctx context.Context, namespace string, labelSelector string) (*appsv1.StatefulSetList, error)
 	ListDaemonSets(ctx context.Context, namespace string, labelSelector string) (*appsv1.DaemonSetList, error)
-	ListPods(ctx context.Context, namespace string, labelSelector string) (*corev1.PodList, error)
+	ListPods(ctx context.Context, namespace string, labelSelector, fieldSelector string) (*corev1.PodList, error)
+	GetDeployment(ctx context.Context, namespace, name string) (*appsv1.Deployment, error)
+
+	// Network operations
+	ListServices(ctx context.Context, namespace string, labelSelector string) (*corev1.ServiceList, error)
+	GetEndpoints(ctx context.Context, namespace, serviceName string) (*corev1.Endpoints, error)
 
 	// Storage operations
 	ListPVCs(ctx context.Context, namespace string, labelSelector string) (*corev1.PersistentVolumeClaimList, error)
 	GetPV(ctx context.Context, name string) (*corev1.PersistentVolume, error)
 	ListPVs(ctx context.Context, labelSelector string) (*corev1.PersistentVolumeList, error)
+	GetStorageClass(ctx context.Context, name string) (*storagev1.StorageClass, error)
 
 	// Configuration operations
 	ListConfigMaps(ctx context.Context, namespace string, labelSelector string) (*corev1.ConfigMapList, error)
 	ListSecrets(ctx context.Context, namespace string, labelSelector string) (*corev1.SecretList, error)
 
+	// Data operation operations
+	GetDataLoads(ctx context.Context, datasetName, namespace string) (*unstructured.UnstructuredList, error)
+	GetDataBackups(ctx context.Context, datasetName, namespace string) (*unstructured.UnstructuredList, error)
+	GetDataMigrates(ctx context.Context, datasetName, namespace string) (*unstructured.UnstructuredList, error)
+
+	// Autoscaling operations
+	ListHorizontalPodAutoscalers(ctx context.Context, namespace string) (*autoscalingv2.HorizontalPodAutoscalerList, error)
+
+	// Event operations
+	ListEvents(ctx context.Context, namespace, involvedObjectName string) (*corev1.EventList, error)
+
+	// Metrics operations (requires metrics-server)
+	ListPodMetrics(ctx context.Context, namespace string, labelSelector string) (*unstructured.UnstructuredList, error)
+
+	// CountResources counts the objects of a kind (e.g. "StatefulSet") in a
+	// namespace matching the label selector, without fetching every body
+	CountResources(ctx context.Context, namespace, kind, labelSelector string) (int, error)
+
+	// GetObjectMeta gets the metadata of a namespaced object of one of the
+	// kinds CountResources supports by name, e.g. to check that a resource
+	// exists under its conventional name and carries the expected labels
+	GetObjectMeta(ctx context.Context, namespace, kind, name string) (metav1.Object, error)
+
 	// Cluster info
 	GetClusterName() string
 }
@@ -93,6 +151,9 @@ type RealClient struct {
 	clusterName   string
 }
 
+// ErrContextNotFound indicates the requested kubeconfig context does not exist
+var ErrContextNotFound = errors.New("kubeconfig context not found")
+
 // ClientConfig holds configuration for creating a Kubernetes client
 type ClientConfig struct {
 	// KubeconfigPath is the path to the kubeconfig file (optional, defaults to in-cluster or ~/.kube/config)
@@ -101,8 +162,23 @@ type ClientConfig struct {
 	// Context is the kubeconfig context to use (optional)
 	Context string
 
-	// InCluster forces in-cluster configuration
+	// InCluster forces in-cluster configuration. Without it, in-cluster
+	// configuration is still used when no kubeconfig is found and the pod's
+	// service account environment is present.
 	InCluster bool
+
+	// Logger, if set, logs every API request at Debug level with its URL,
+	// status and duration
+	Logger *slog.Logger
+
+	// QPS and Burst rate-limit API requests, shared by the typed and dynamic
+	// clients (optional, defaults to client-go's 5 QPS and burst of 10)
+	QPS   float32
+	Burst int
+
+	// MaxConcurrentRequests caps the API requests in flight at once, e.g.
+	// during parallel discovery (optional, 0 means no limit)
+	MaxConcurrentRequests int
 }
 
 // NewClient creates a new Kubernetes client with the given configuration
@@ -110,25 +186,17 @@ func NewClient(cfg ClientConfig) (*RealClient, error) {
 	var restConfig *rest.Config
 	var err error
 
-	if cfg.InCluster {
+	// Cluster name from the kubeconfig entry of the selected context, if any
+	clusterName := ""
+
+	if cfg.InCluster || shouldUseInCluster(cfg) {
 		restConfig, err = rest.InClusterConfig()
 		if err != nil {
 			return nil, fmt.Errorf("failed to get in-cluster config: %w", err)
 		}
 	} else {
-		kubeconfigPath := cfg.KubeconfigPath
-		if kubeconfigPath == "" {
-			// Try default locations
-			if home := os.Getenv("HOME"); home != "" {
-				kubeconfigPath = filepath.Join(home, ".kube", "config")
-			}
-			if envKubeconfig := os.Getenv("KUBECONFIG"); envKubeconfig != "" {
-				kubeconfigPath = envKubeconfig
-			}
-		}
-
 		loadingRules := clientcmd.NewDefaultClientConfigLoadingRules()
-		loadingRules.ExplicitPath = kubeconfigPath
+		loadingRules.ExplicitPath = resolveKubeconfigPath(cfg.KubeconfigPath)
 
 		configOverrides := &clientcmd.ConfigOverrides{}
 		if cfg.Context != "" {
@@ -137,12 +205,101 @@ func NewClient(cfg ClientConfig) (*RealClient, error) {
 
 		kubeConfig := clientcmd.NewNonInteractiveDeferredLoadingClientConfig(loadingRules, configOverrides)
 
+		rawConfig, err := kubeConfig.RawConfig()
+		if err != nil {
+			return nil, fmt.Errorf("failed to load kubeconfig: %w", err)
+		}
+
+		// Reject unknown contexts up front instead of failing deep in client setup
+		contextName := cfg.Context
+		if contextName == "" {
+			contextName = rawConfig.CurrentContext
+		}
+		kubeContext, ok := rawConfig.Contexts[contextName]
+		if !ok && cfg.Context != "" {
+			return nil, fmt.Errorf("%w: %q (available: %s)", ErrContextNotFound, cfg.Context, strings.Join(contextNames(rawConfig), ", "))
+		}
+		if ok {
+			clusterName = kubeContext.Cluster
+		}
+
 		restConfig, err = kubeConfig.ClientConfig()
 		if err != nil {
 			return nil, fmt.Errorf("failed to build kubeconfig: %w", err)
 		}
 	}
 
+	return newClientForConfig(restConfig, clusterName, cfg)
+}
+
+// NewClientFromConfig creates a client from an existing REST config, e.g. one
+// built by a service embedding the mapper. The config is copied, not modified;
+// its QPS, Burst and RateLimiter are kept when set.
+func NewClientFromConfig(restConfig *rest.Config) (*RealClient, error) {
+	if restConfig == nil {
+		return nil, errors.New("rest config is nil")
+	}
+	return newClientForConfig(rest.CopyConfig(restConfig), "", ClientConfig{})
+}
+
+// NewClientFromKubeconfigBytes creates a client from the contents of a
+// kubeconfig, e.g. read from a Secret, using its current context. Unlike
+// NewClient it never reads the filesystem or the environment.
+func NewClientFromKubeconfigBytes(kubeconfig []byte) (*RealClient, error) {
+	kubeConfig, err := clientcmd.NewClientConfigFromBytes(kubeconfig)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load kubeconfig: %w", err)
+	}
+
+	rawConfig, err := kubeConfig.RawConfig()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load kubeconfig: %w", err)
+	}
+	clusterName := ""
+	if kubeContext, ok := rawConfig.Contexts[rawConfig.CurrentContext]; ok {
+		clusterName = kubeContext.Cluster
+	}
+
+	restConfig, err := kubeConfig.ClientConfig()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build kubeconfig: %w", err)
+	}
+	return newClientForConfig(restConfig, clusterName, ClientConfig{})
+}
+
+// newClientForConfig applies the throttling and logging options of cfg to
+// restConfig and creates the typed and dynamic clients from it
+func newClientForConfig(restConfig *rest.Config, clusterName string, cfg ClientConfig) (*RealClient, error) {
+	if restConfig.QPS == 0 {
+		restConfig.QPS = rest.DefaultQPS
+	}
+	if restConfig.Burst == 0 {
+		restConfig.Burst = rest.DefaultBurst
+	}
+	if cfg.QPS > 0 {
+		restConfig.QPS = cfg.QPS
+	}
+	if cfg.Burst > 0 {
+		restConfig.Burst = cfg.Burst
+	}
+	// One limiter for both clients, which would otherwise each get their own
+	if restConfig.RateLimiter == nil {
+		restConfig.RateLimiter = flowcontrol.NewTokenBucketRateLimiter(restConfig.QPS, restConfig.Burst)
+	}
+
+	if cfg.MaxConcurrentRequests > 0 {
+		slots := make(chan struct{}, cfg.MaxConcurrentRequests)
+		restConfig.Wrap(func(rt http.RoundTripper) http.RoundTripper {
+			return &limitingRoundTripper{next: rt, slots: slots}
+		})
+	}
+
+	if cfg.Logger != nil {
+		restConfig.Wrap(func(rt http.RoundTripper) http.RoundTripper {
+			return &loggingRoundTripper{next: rt, logger: cfg.Logger}
+		})
+	}
+
 	// Create clientset
 	clientset, err := kubernetes.NewForConfig(restConfig)
 	if err != nil {
@@ -155,10 +312,9 @@ func NewClient(cfg ClientConfig) (*RealClient, error) {
 		return nil, fmt.Errorf("failed to create dynamic client: %w", err)
 	}
 
-	// Try to determine cluster name
-	clusterName := "unknown"
-	if cfg.Context != "" {
-		clusterName = cfg.Context
+	// Fall back to the API server host when the kubeconfig has no cluster name
+	if clusterName == "" {
+		clusterName = serverHost(restConfig.Host)
 	}
 
 	return &RealClient{
@@ -168,6 +324,142 @@ func NewClient(cfg ClientConfig) (*RealClient, error) {
 	}, nil
 }
 
+// ListContexts returns the sorted context names defined in a kubeconfig file.
+// An empty path uses the same defaults as NewClient.
+func ListContexts(kubeconfigPath string) ([]string, error) {
+	loadingRules := clientcmd.NewDefaultClientConfigLoadingRules()
+	loadingRules.ExplicitPath = resolveKubeconfigPath(kubeconfigPath)
+
+	rawConfig, err := loadingRules.Load()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load kubeconfig: %w", err)
+	}
+	return contextNames(*rawConfig), nil
+}
+
+// shouldUseInCluster reports whether to fall back to in-cluster configuration:
+// no kubeconfig was requested or found, and the Kubernetes service environment
+// variables injected into every pod are set
+func shouldUseInCluster(cfg ClientConfig) bool {
+	if cfg.KubeconfigPath != "" || cfg.Context != "" || os.Getenv("KUBECONFIG") != "" {
+		return false
+	}
+	if path := resolveKubeconfigPath(""); path != "" {
+		if _, err := os.Stat(path); err == nil {
+			return false
+		}
+	}
+	return os.Getenv("KUBERNETES_SERVICE_HOST") != "" && os.Getenv("KUBERNETES_SERVICE_PORT") != ""
+}
+
+// resolveKubeconfigPath returns path, or $KUBECONFIG, or ~/.kube/config
+func resolveKubeconfigPath(path string) string {
+	if path != "" {
+		return path
+	}
+	if envKubeconfig := os.Getenv("KUBECONFIG"); envKubeconfig != "" {
+		return envKubeconfig
+	}
+	if home := os.Getenv("HOME"); home != "" {
+		return filepath.Join(home, ".kube", "config")
+	}
+	return ""
+}
+
+// serverHost extracts the host of an API server address, or "unknown"
+func serverHost(server string) string {
+	if !strings.Contains(server, "://") {
+		server = "https://" + server
+	}
+	if u, err := url.Parse(server); err == nil && u.Hostname() != "" {
+		return u.Hostname()
+	}
+	return "unknown"
+}
+
+// contextNames returns the sorted context names of a kubeconfig
+func contextNames(config clientcmdapi.Config) []string {
+	names := make([]string, 0, len(config.Contexts))
+	for name := range config.Contexts {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// loggingRoundTripper logs each API request, including label and field
+// selectors from the query string, with the response status and duration
+type loggingRoundTripper struct {
+	next   http.RoundTripper
+	logger *slog.Logger
+}
+
+// RoundTrip performs the request and logs its outcome
+func (l *loggingRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	start := time.Now()
+	resp, err := l.next.RoundTrip(req)
+	if err != nil {
+		l.logger.Debug("api request failed", "method", req.Method, "url", req.URL.String(), "duration", time.Since(start), "error", err)
+		return resp, err
+	}
+	l.logger.Debug("api request", "method", req.Method, "url", req.URL.String(), "status", resp.StatusCode, "duration", time.Since(start))
+	return resp, nil
+}
+
+// namespacedKinds maps the kinds CountResources and GetObjectMeta support to their resources
+var namespacedKinds = map[string]schema.GroupVersionResource{
+	"StatefulSet":           {Group: "apps", Version: "v1", Resource: "statefulsets"},
+	"DaemonSet":             {Group: "apps", Version: "v1", Resource: "daemonsets"},
+	"Pod":                   {Version: "v1", Resource: "pods"},
+	"Service":               {Version: "v1", Resource: "services"},
+	"PersistentVolumeClaim": {Version: "v1", Resource: "persistentvolumeclaims"},
+	"ConfigMap":             {Version: "v1", Resource: "configmaps"},
+	"Secret":                {Version: "v1", Resource: "secrets"},
+}
+
+// countPageSize is the page size used when the API server does not report
+// the remaining item count, which it omits for label-selected lists
+const countPageSize = 500
+
+// CountResources counts matching objects by requesting a single item and
+// reading the list's remainingItemCount. When the server leaves that unset
+// it pages through the list instead, keeping only the count.
+func (c *RealClient) CountResources(ctx context.Context, namespace, kind, labelSelector string) (int, error) {
+	gvr, ok := namespacedKinds[kind]
+	if !ok {
+		return 0, fmt.Errorf("cannot count kind %s", kind)
+	}
+	resource := c.dynamicClient.Resource(gvr).Namespace(namespace)
+
+	opts := metav1.ListOptions{LabelSelector: labelSelector, Limit: 1}
+	count := 0
+	for {
+		list, err := resource.List(ctx, opts)
+		if err != nil {
+			return 0, err
+		}
+		count += len(list.Items)
+		if list.GetContinue() == "" {
+			return count, nil
+		}
+		if remaining := list.GetRemainingItemCount(); remaining != nil {
+			return count + int(*remaining), nil
+		}
+		opts.Continue = list.GetContinue()
+		opts.Limit = countPageSize
+	}
+}
+
+// GetObjectMeta gets an object by name through the dynamic client; the
+// unstructured object serves as its own metadata
+func (c *RealClient) GetObjectMeta(ctx context.Context, namespace, kind, name string) (metav1.Object, error) {
+	gvr, ok := namespacedKinds[kind]
+	if !ok {
+		return nil, fmt.Errorf("cannot get kind %s", kind)
+	}
+	return c.dynamicClient.Resource(gvr).Namespace(namespace).Get(ctx, name, metav1.GetOptions{})
+}
+
 // GetClusterName returns the cluster name
 func (c *RealClient) GetClusterName() string {
 	return c.clusterName
@@ -178,7 +470,7 @@ func (c *RealClient) GetDataset(ctx context.Context, name, namespace string) (*u
 	return c.dynamicClient.Resource(DatasetGVR).Namespace(namespace).Get(ctx, name, metav1.GetOptions{})
 }
 
-// ListDatasets lists all Datasets in a namespace
+// ListDatasets lists all Datasets in a namespace, or cluster-wide if namespace is empty
 func (c *RealClient) ListDatasets(ctx context.Context, namespace string) (*unstructured.UnstructuredList, error) {
 	return c.dynamicClient.Resource(DatasetGVR).Namespace(namespace).List(ctx, metav1.ListOptions{})
 }
@@ -192,6 +484,11 @@ func (c *RealClient) GetRuntime(ctx context.Context, runtimeType, name, namespac
 	return c.dynamicClient.Resource(gvr).Namespace(namespace).Get(ctx, name, metav1.GetOptions{})
 }
 
+// GetThinRuntimeProfile gets a cluster-scoped ThinRuntimeProfile by name
+func (c *RealClient) GetThinRuntimeProfile(ctx context.Context, name string) (*unstructured.Unstructured, error) {
+	return c.dynamicClient.Resource(ThinRuntimeProfileGVR).Get(ctx, name, metav1.GetOptions{})
+}
+
 // ListStatefulSets lists StatefulSets in a namespace with optional label selector
 func (c *RealClient) ListStatefulSets(ctx context.Context, namespace string, labelSelector string) (*appsv1.StatefulSetList, error) {
 	return c.clientset.AppsV1().StatefulSets(namespace).List(ctx, metav1.ListOptions{
@@ -206,13 +503,100 @@ func (c *RealClient) ListDaemonSets(ctx context.Context, namespace string, label
 	})
 }
 
-// ListPods lists Pods in a namespace with optional label selector
-func (c *RealClient) ListPods(ctx context.Context, namespace string, labelSelector string) (*corev1.PodList, error) {
+// GetDeployment gets a Deployment by name, e.g. a Fluid controller
+func (c *RealClient) GetDeployment(ctx context.Context, namespace, name string) (*appsv1.Deployment, error) {
+	return c.clientset.AppsV1().Deployments(namespace).Get(ctx, name, metav1.GetOptions{})
+}
+
+// ListPods lists Pods in a namespace with optional label and field selectors
+func (c *RealClient) ListPods(ctx context.Context, namespace string, labelSelector, fieldSelector string) (*corev1.PodList, error) {
 	return c.clientset.CoreV1().Pods(namespace).List(ctx, metav1.ListOptions{
 		LabelSelector: labelSelector,
+		FieldSelector: fieldSelector,
 	})
 }
 
+// podSelectableFields are the pod fields the API server accepts in field selectors
+var podSelectableFields = []string{
+	"metadata.name",
+	"metadata.namespace",
+	"spec.nodeName",
+	"spec.restartPolicy",
+	"spec.schedulerName",
+	"spec.serviceAccountName",
+	"status.phase",
+	"status.podIP",
+	"status.nominatedNodeName",
+}
+
+// ValidatePodFieldSelector checks that a pod field selector parses and only
+// uses fields the API server supports for pods
+func ValidatePodFieldSelector(fieldSelector string) error {
+	selector, err := fields.ParseSelector(fieldSelector)
+	if err != nil {
+		return fmt.Errorf("invalid pod field selector %q: %w", fieldSelector, err)
+	}
+	for _, req := range selector.Requirements() {
+		if !containsString(podSelectableFields, req.Field) {
+			return fmt.Errorf("invalid pod field selector %q: unsupported field %q (supported: %s)",
+				fieldSelector, req.Field, strings.Join(podSelectableFields, ", "))
+		}
+	}
+	return nil
+}
+
+// filterPodsByFields keeps the pods matching a field selector, for clients
+// that do not evaluate field selectors server-side
+func filterPodsByFields(pods []corev1.Pod, fieldSelector string) ([]corev1.Pod, error) {
+	if fieldSelector == "" {
+		return pods, nil
+	}
+	if err := ValidatePodFieldSelector(fieldSelector); err != nil {
+		return nil, err
+	}
+	selector, _ := fields.ParseSelector(fieldSelector)
+
+	var matched []corev1.Pod
+	for _, pod := range pods {
+		podFields := fields.Set{
+			"metadata.name":            pod.Name,
+			"metadata.namespace":       pod.Namespace,
+			"spec.nodeName":            pod.Spec.NodeName,
+			"spec.restartPolicy":       string(pod.Spec.RestartPolicy),
+			"spec.schedulerName":       pod.Spec.SchedulerName,
+			"spec.serviceAccountName":  pod.Spec.ServiceAccountName,
+			"status.phase":             string(pod.Status.Phase),
+			"status.podIP":             pod.Status.PodIP,
+			"status.nominatedNodeName": pod.Status.NominatedNodeName,
+		}
+		if selector.Matches(podFields) {
+			matched = append(matched, pod)
+		}
+	}
+	return matched, nil
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+// ListServices lists Services in a namespace with optional label selector
+func (c *RealClient) ListServices(ctx context.Context, namespace string, labelSelector string) (*corev1.ServiceList, error) {
+	return c.clientset.CoreV1().Services(namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: labelSelector,
+	})
+}
+
+// GetEndpoints retrieves the Endpoints of a Service
+func (c *RealClient) GetEndpoints(ctx context.Context, namespace, serviceName string) (*corev1.Endpoints, error) {
+	return c.clientset.CoreV1().Endpoints(namespace).Get(ctx, serviceName, metav1.GetOptions{})
+}
+
 // ListPVCs lists PersistentVolumeClaims in a namespace with optional label selector
 func (c *RealClient) ListPVCs(ctx context.Context, namespace string, labelSelector string) (*corev1.PersistentVolumeClaimList, error) {
 	return c.clientset.CoreV1().PersistentVolumeClaims(namespace).List(ctx, metav1.ListOptions{
@@ -232,6 +616,11 @@ func (c *RealClient) ListPVs(ctx context.Context, labelSelector string) (*corev1
 	})
 }
 
+// GetStorageClass gets a StorageClass by name
+func (c *RealClient) GetStorageClass(ctx context.Context, name string) (*storagev1.StorageClass, error) {
+	return c.clientset.StorageV1().StorageClasses().Get(ctx, name, metav1.GetOptions{})
+}
+
 // ListConfigMaps lists ConfigMaps in a namespace with optional label selector
 func (c *RealClient) ListConfigMaps(ctx context.Context, namespace string, labelSelector string) (*corev1.ConfigMapList, error) {
 	return c.clientset.CoreV1().ConfigMaps(namespace).List(ctx, metav1.ListOptions{
@@ -245,3 +634,128 @@ func (c *RealClient) ListSecrets(ctx context.Context, namespace string, labelSel
 		LabelSelector: labelSelector,
 	})
 }
+
+// ListHorizontalPodAutoscalers lists the HorizontalPodAutoscalers in a namespace.
+// HPAs are rarely labeled with the release, so callers match them by scale target.
+func (c *RealClient) ListHorizontalPodAutoscalers(ctx context.Context, namespace string) (*autoscalingv2.HorizontalPodAutoscalerList, error) {
+	return c.clientset.AutoscalingV2().HorizontalPodAutoscalers(namespace).List(ctx, metav1.ListOptions{})
+}
+
+// ListEvents lists Events in a namespace whose involved object has the given name
+func (c *RealClient) ListEvents(ctx context.Context, namespace, involvedObjectName string) (*corev1.EventList, error) {
+	return c.clientset.CoreV1().Events(namespace).List(ctx, metav1.ListOptions{
+		FieldSelector: fields.OneTermEqualSelector("involvedObject.name", involvedObjectName).String(),
+	})
+}
+
+// ListPodMetrics lists PodMetrics from metrics.k8s.io with optional label selector
+func (c *RealClient) ListPodMetrics(ctx context.Context, namespace string, labelSelector string) (*unstructured.UnstructuredList, error) {
+	return c.dynamicClient.Resource(PodMetricsGVR).Namespace(namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: labelSelector,
+	})
+}
+
+// GetDataLoads lists the DataLoads in a namespace that target the given Dataset
+func (c *RealClient) GetDataLoads(ctx context.Context, datasetName, namespace string) (*unstructured.UnstructuredList, error) {
+	list, err := c.dynamicClient.Resource(DataLoadGVR).Namespace(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	return filterByTargetDataset(list, datasetName, namespace, []string{"spec", "dataset"}), nil
+}
+
+// GetDataBackups lists the DataBackups in a namespace that back up the given Dataset
+func (c *RealClient) GetDataBackups(ctx context.Context, datasetName, namespace string) (*unstructured.UnstructuredList, error) {
+	list, err := c.dynamicClient.Resource(DataBackupGVR).Namespace(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	return filterDataBackups(list, datasetName), nil
+}
+
+// filterDataBackups keeps the DataBackups of the given Dataset. DataBackup
+// references the Dataset by name only, in its own namespace.
+func filterDataBackups(list *unstructured.UnstructuredList, datasetName string) *unstructured.UnstructuredList {
+	filtered := &unstructured.UnstructuredList{Object: list.Object}
+	for _, item := range list.Items {
+		if name, _, _ := unstructured.NestedString(item.Object, "spec", "dataset"); name == datasetName {
+			filtered.Items = append(filtered.Items, item)
+		}
+	}
+	return filtered
+}
+
+// GetDataMigrates lists the DataMigrates in a namespace that migrate data from or to the given Dataset
+func (c *RealClient) GetDataMigrates(ctx context.Context, datasetName, namespace string) (*unstructured.UnstructuredList, error) {
+	list, err := c.dynamicClient.Resource(DataMigrateGVR).Namespace(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	return filterByTargetDataset(list, datasetName, namespace,
+		[]string{"spec", "from", "dataset"},
+		[]string{"spec", "to", "dataset"},
+	), nil
+}
+
+// filterByTargetDataset keeps the data operations with a dataset reference
+// ({name, namespace}) at any of the given paths matching the given Dataset.
+// An empty reference namespace means the operation's namespace.
+func filterByTargetDataset(list *unstructured.UnstructuredList, datasetName, namespace string, paths ...[]string) *unstructured.UnstructuredList {
+	filtered := &unstructured.UnstructuredList{Object: list.Object}
+	for _, item := range list.Items {
+		for _, path := range paths {
+			name, _, _ := unstructured.NestedString(item.Object, append(path, "name")...)
+			ns, _, _ := unstructured.NestedString(item.Object, append(path, "namespace")...)
+			if ns == "" {
+				ns = item.GetNamespace()
+			}
+			if name == datasetName && ns == namespace {
+				filtered.Items = append(filtered.Items, item)
+				break
+			}
+		}
+	}
+	return filtered
+}
+
+// limitingRoundTripper caps the number of API requests in flight. A slot is
+// held until the response body is closed, so reading a large list counts.
+type limitingRoundTripper struct {
+	next  http.RoundTripper
+	slots chan struct{}
+}
+
+// RoundTrip waits for a free slot, or for the request to be cancelled,
+// before performing the request
+func (l *limitingRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	select {
+	case l.slots <- struct{}{}:
+	case <-req.Context().Done():
+		return nil, req.Context().Err()
+	}
+
+	var once sync.Once
+	release := func() { once.Do(func() { <-l.slots }) }
+
+	resp, err := l.next.RoundTrip(req)
+	if err != nil || resp.Body == nil {
+		release()
+		return resp, err
+	}
+	resp.Body = &releasingBody{ReadCloser: resp.Body, release: release}
+	return resp, nil
+}
+
+// releasingBody frees its request slot when closed
+type releasingBody struct {
+	io.ReadCloser
+	release func()
+}
+
+// Close closes the body and frees the slot
+func (b *releasingBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.release()
+	return err
+}
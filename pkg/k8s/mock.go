@@ -4,13 +4,21 @@ package k8s
 import (
 	"context"
 	"fmt"
+	"hash/fnv"
+	"strings"
 	"time"
 
 	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
 	corev1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/selection"
+	"k8s.io/apimachinery/pkg/types"
 )
 
 // MockClient implements the Client interface with mock data for demos and testing
@@ -43,6 +51,47 @@ const (
 
 	// ScenarioMultipleDatasets represents multiple datasets in the namespace
 	ScenarioMultipleDatasets MockScenario = "multiple"
+
+	// ScenarioStaleRevision represents a worker rollout with some pods left on the old revision
+	ScenarioStaleRevision MockScenario = "stale-revision"
+
+	// ScenarioScaling represents a worker StatefulSet being scaled up
+	ScenarioScaling MockScenario = "scaling"
+
+	// ScenarioNoEndpoints represents a master Service whose selector matches no ready pods
+	ScenarioNoEndpoints MockScenario = "no-endpoints"
+
+	// ScenarioSidecarFuse represents a deployment whose fuse is injected as a sidecar into application pods
+	ScenarioSidecarFuse MockScenario = "sidecar-fuse"
+
+	// ScenarioAppLabelOnly represents worker resources labeled app=alluxio but missing the release label
+	ScenarioAppLabelOnly MockScenario = "app-label-only"
+
+	// ScenarioControllerDown represents a runtime whose workloads were never created because its controller is crash-looping
+	ScenarioControllerDown MockScenario = "controller-down"
+
+	// ScenarioCrossNamespace represents a Dataset bound to a Runtime, and its
+	// resources, in another namespace
+	ScenarioCrossNamespace MockScenario = "cross-namespace"
+
+	// ScenarioTerminating represents a Runtime stuck deleting: it, its worker
+	// StatefulSet and pods and the PVC carry a deletion timestamp and finalizers
+	ScenarioTerminating MockScenario = "terminating"
+
+	// ScenarioDatasetLabel represents a newer Fluid release labeling resources with fluid.io/dataset
+	ScenarioDatasetLabel MockScenario = "dataset-label"
+)
+
+// mockRuntimeNamespace is where the cross-namespace scenario's Runtime lives
+const mockRuntimeNamespace = "fluid-cache"
+
+// mockDeletionAge is how long ago the terminating scenario's deletion was requested
+const mockDeletionAge = 20 * time.Minute
+
+// Mock controller revisions used for StatefulSets and their pods
+const (
+	mockCurrentRevision = "7d9f8b6c5"
+	mockStaleRevision   = "5c4b3a291"
 )
 
 // NewMockClient creates a new mock client with the specified scenario
@@ -62,44 +111,56 @@ func (m *MockClient) GetDataset(ctx context.Context, name, namespace string) (*u
 	}
 
 	// Default: bound dataset
+	runtimeNamespace := namespace
+	if m.Scenario == ScenarioCrossNamespace {
+		runtimeNamespace = mockRuntimeNamespace
+	}
 	runtimes := []interface{}{
 		map[string]interface{}{
 			"name":      name,
-			"namespace": namespace,
+			"namespace": runtimeNamespace,
 			"type":      "alluxio",
 		},
 	}
 	return createMockDataset(name, namespace, "Bound", runtimes), nil
 }
 
-// ListDatasets returns mock Dataset list
+// ListDatasets returns mock Dataset list. An empty namespace lists datasets
+// spread across several tenant namespaces.
 func (m *MockClient) ListDatasets(ctx context.Context, namespace string) (*unstructured.UnstructuredList, error) {
 	datasets := &unstructured.UnstructuredList{}
 	datasets.SetAPIVersion("data.fluid.io/v1alpha1")
 	datasets.SetKind("DatasetList")
 
+	// Datasets by name, with the namespace they live in when listing cluster-wide
+	entries := []struct{ name, namespace string }{
+		{"demo-data", "default"},
+		{"training-data", "team-ml"},
+	}
 	if m.Scenario == ScenarioMultipleDatasets {
-		// Return multiple datasets
-		for _, name := range []string{"dataset-alpha", "dataset-beta", "dataset-gamma"} {
-			runtimes := []interface{}{
-				map[string]interface{}{
-					"name":      name,
-					"namespace": namespace,
-					"type":      "alluxio",
-				},
-			}
-			datasets.Items = append(datasets.Items, *createMockDataset(name, namespace, "Bound", runtimes))
+		entries = []struct{ name, namespace string }{
+			{"dataset-alpha", "team-a"},
+			{"dataset-beta", "team-a"},
+			{"dataset-gamma", "team-b"},
 		}
-	} else {
+	} else if namespace != "" {
 		// Single demo-data dataset
+		entries = entries[:1]
+	}
+
+	for _, entry := range entries {
+		ns := namespace
+		if ns == "" {
+			ns = entry.namespace
+		}
 		runtimes := []interface{}{
 			map[string]interface{}{
-				"name":      "demo-data",
-				"namespace": namespace,
+				"name":      entry.name,
+				"namespace": ns,
 				"type":      "alluxio",
 			},
 		}
-		datasets.Items = append(datasets.Items, *createMockDataset("demo-data", namespace, "Bound", runtimes))
+		datasets.Items = append(datasets.Items, *createMockDataset(entry.name, ns, "Bound", runtimes))
 	}
 
 	return datasets, nil
@@ -116,6 +177,21 @@ func (m *MockClient) GetRuntime(ctx context.Context, runtimeType, name, namespac
 	runtime.SetKind("AlluxioRuntime")
 	runtime.SetName(name)
 	runtime.SetNamespace(namespace)
+	// Owner references cannot cross namespaces, so a Runtime bound from
+	// another namespace is not owned by its Dataset
+	if m.Scenario != ScenarioCrossNamespace {
+		runtime.SetOwnerReferences([]metav1.OwnerReference{
+			{
+				APIVersion: "data.fluid.io/v1alpha1",
+				Kind:       "Dataset",
+				Name:       name,
+				UID:        types.UID("mock-uid-dataset-" + name),
+			},
+		})
+	}
+	if m.Scenario == ScenarioTerminating {
+		markMockTerminating(runtime, "alluxio-runtime-controller-finalizer")
+	}
 
 	masterPhase := "Ready"
 	workerPhase := "Ready"
@@ -137,15 +213,33 @@ func (m *MockClient) GetRuntime(ctx context.Context, runtimeType, name, namespac
 	case ScenarioFailedPods:
 		workerPhase = "Failed"
 		workerCurrent = 0
+	case ScenarioSidecarFuse:
+		fuseCurrent = 0
+		fuseDesired = 0
+	case ScenarioControllerDown:
+		masterPhase, workerPhase, fusePhase = "NotReady", "NotReady", "NotReady"
+		masterCurrent, workerCurrent, fuseCurrent = 0, 0, 0
+	}
+
+	// The scaling scenario's StatefulSet is following a scale-up of the runtime
+	workerReplicas := 2
+	if m.Scenario == ScenarioScaling {
+		workerReplicas = 3
 	}
 
 	runtime.Object["spec"] = map[string]interface{}{
-		"replicas": 2,
+		"replicas": workerReplicas,
 		"master": map[string]interface{}{
 			"replicas": 1,
 		},
 		"worker": map[string]interface{}{
-			"replicas": 2,
+			"replicas": workerReplicas,
+		},
+		"tieredstore": map[string]interface{}{
+			"levels": []interface{}{
+				map[string]interface{}{"mediumtype": "MEM", "path": "/dev/shm", "quota": "2Gi"},
+				map[string]interface{}{"mediumtype": "SSD", "path": "/mnt/ssd", "quota": "20Gi"},
+			},
 		},
 	}
 	runtime.Object["status"] = map[string]interface{}{
@@ -158,6 +252,10 @@ func (m *MockClient) GetRuntime(ctx context.Context, runtimeType, name, namespac
 		"desiredWorkerNumberScheduled": workerDesired,
 		"currentFuseNumberScheduled":   fuseCurrent,
 		"desiredFuseNumberScheduled":   fuseDesired,
+		"cacheStates": map[string]interface{}{
+			"cacheCapacity": "44.00GiB",
+			"cached":        "25.00GiB",
+		},
 		"conditions": []interface{}{
 			map[string]interface{}{
 				"type":               "Ready",
@@ -172,12 +270,28 @@ func (m *MockClient) GetRuntime(ctx context.Context, runtimeType, name, namespac
 	return runtime, nil
 }
 
+// GetThinRuntimeProfile returns a mock s3fs ThinRuntimeProfile
+func (m *MockClient) GetThinRuntimeProfile(ctx context.Context, name string) (*unstructured.Unstructured, error) {
+	profile := &unstructured.Unstructured{}
+	profile.SetAPIVersion("data.fluid.io/v1alpha1")
+	profile.SetKind("ThinRuntimeProfile")
+	profile.SetName(name)
+	profile.Object["spec"] = map[string]interface{}{
+		"fileSystemType": "s3fs",
+		"fuse": map[string]interface{}{
+			"image":    "fluidcloudnative/s3fs-fuse",
+			"imageTag": "v1.0.0",
+		},
+	}
+	return profile, nil
+}
+
 // ListStatefulSets returns mock StatefulSet list
 func (m *MockClient) ListStatefulSets(ctx context.Context, namespace string, labelSelector string) (*appsv1.StatefulSetList, error) {
 	list := &appsv1.StatefulSetList{}
 
 	// Parse release name from label selector
-	releaseName := "demo-data" // default
+	releaseName := mockReleaseName(namespace, labelSelector)
 
 	// Master StatefulSet
 	masterSts := createMockStatefulSet(releaseName+"-master", namespace, releaseName, "alluxio-master", 1, 1)
@@ -192,20 +306,33 @@ func (m *MockClient) ListStatefulSets(ctx context.Context, namespace string, lab
 		workerReady = 0
 	}
 	workerSts := createMockStatefulSet(releaseName+"-worker", namespace, releaseName, "alluxio-worker", workerReplicas, workerReady)
+	if m.Scenario == ScenarioOrphaned {
+		workerSts.OwnerReferences = nil
+	}
+	if m.Scenario == ScenarioStaleRevision {
+		workerSts.Status.CurrentRevision = workerSts.Name + "-" + mockStaleRevision
+		workerSts.Status.UpdatedReplicas = 1
+	}
+	if m.Scenario == ScenarioScaling {
+		desired := int32(3)
+		workerSts.Spec.Replicas = &desired
+	}
 	list.Items = append(list.Items, workerSts)
 
-	return list, nil
+	var err error
+	list.Items, err = selectMockItems(m, list.Items, namespace, labelSelector)
+	return list, err
 }
 
 // ListDaemonSets returns mock DaemonSet list
 func (m *MockClient) ListDaemonSets(ctx context.Context, namespace string, labelSelector string) (*appsv1.DaemonSetList, error) {
 	list := &appsv1.DaemonSetList{}
 
-	if m.Scenario == ScenarioMissingFuse {
+	if m.Scenario == ScenarioMissingFuse || m.Scenario == ScenarioSidecarFuse {
 		return list, nil // No fuse DaemonSet
 	}
 
-	releaseName := "demo-data"
+	releaseName := mockReleaseName(namespace, labelSelector)
 	desired := int32(3)
 	ready := int32(3)
 
@@ -216,16 +343,21 @@ func (m *MockClient) ListDaemonSets(ctx context.Context, namespace string, label
 	fuseDs := createMockDaemonSet(releaseName+"-fuse", namespace, releaseName, "alluxio-fuse", desired, ready)
 	list.Items = append(list.Items, fuseDs)
 
-	return list, nil
+	var err error
+	list.Items, err = selectMockItems(m, list.Items, namespace, labelSelector)
+	return list, err
 }
 
 // ListPods returns mock Pod list
-func (m *MockClient) ListPods(ctx context.Context, namespace string, labelSelector string) (*corev1.PodList, error) {
+func (m *MockClient) ListPods(ctx context.Context, namespace string, labelSelector, fieldSelector string) (*corev1.PodList, error) {
 	list := &corev1.PodList{}
-	releaseName := "demo-data"
+	releaseName := mockReleaseName(namespace, labelSelector)
 
 	// Master pod
 	masterPod := createMockPod(releaseName+"-master-0", namespace, releaseName, "alluxio-master", corev1.PodRunning)
+	masterPod.Labels[appsv1.StatefulSetRevisionLabel] = releaseName + "-master-" + mockCurrentRevision
+	masterPod.OwnerReferences = mockOwnerReferences("StatefulSet", releaseName+"-master")
+	masterPod.Spec.NodeName = mockNodeName(0)
 	list.Items = append(list.Items, masterPod)
 
 	// Worker pods
@@ -239,33 +371,171 @@ func (m *MockClient) ListPods(ctx context.Context, namespace string, labelSelect
 			status = corev1.PodPending
 		}
 		workerPod := createMockPod(fmt.Sprintf("%s-worker-%d", releaseName, i), namespace, releaseName, "alluxio-worker", status)
+		revision := mockCurrentRevision
+		if m.Scenario == ScenarioStaleRevision && i == 1 {
+			revision = mockStaleRevision
+		}
+		workerPod.Labels[appsv1.StatefulSetRevisionLabel] = releaseName + "-worker-" + revision
+		if revision == mockStaleRevision {
+			workerPod.Spec.Containers[0].Image = "alluxio/alluxio:2.8.1"
+		}
+		if status != corev1.PodPending {
+			workerPod.Spec.NodeName = mockNodeName(i + 1)
+		}
+		if m.Scenario != ScenarioOrphaned {
+			workerPod.OwnerReferences = mockOwnerReferences("StatefulSet", releaseName+"-worker")
+		}
 		list.Items = append(list.Items, workerPod)
 	}
 
-	// Fuse pods
-	if m.Scenario != ScenarioMissingFuse {
+	// Fuse pods, or an application pod with the fuse injected as a sidecar
+	if m.Scenario == ScenarioSidecarFuse {
+		list.Items = append(list.Items, createMockSidecarPod(releaseName+"-app-0", namespace, releaseName))
+	} else if m.Scenario != ScenarioMissingFuse {
 		fuseCount := 3
 		if m.Scenario == ScenarioPartialReady {
 			fuseCount = 2
 		}
 		for i := 0; i < fuseCount; i++ {
-			fusePod := createMockPod(fmt.Sprintf("%s-fuse-%s", releaseName, generateHash(i)), namespace, releaseName, "alluxio-fuse", corev1.PodRunning)
+			fusePod := createMockPod(fmt.Sprintf("%s-fuse-%s", releaseName, mockPodSuffix(releaseName, i)), namespace, releaseName, "alluxio-fuse", corev1.PodRunning)
+			fusePod.OwnerReferences = mockOwnerReferences("DaemonSet", releaseName+"-fuse")
+			fusePod.Spec.NodeName = mockNodeName(i)
 			list.Items = append(list.Items, fusePod)
 		}
 	}
 
-	return list, nil
+	// An application pod mounting the Dataset's PVC
+	if m.Scenario != ScenarioSidecarFuse && m.Scenario != ScenarioMissingRuntime {
+		list.Items = append(list.Items, createMockConsumerPod(releaseName+"-training-0", namespace, releaseName))
+	}
+
+	// The runtime controller's pod lives in Fluid's namespace
+	if namespace == mockControllerNamespace {
+		list.Items = append(list.Items, createMockControllerPod(m.Scenario == ScenarioControllerDown))
+	}
+
+	var err error
+	if list.Items, err = selectMockItems(m, list.Items, namespace, labelSelector); err != nil {
+		return nil, err
+	}
+	list.Items, err = filterPodsByFields(list.Items, fieldSelector)
+	return list, err
+}
+
+// mockControllerNamespace and mockController name the mock AlluxioRuntime controller Deployment
+const (
+	mockControllerNamespace = "fluid-system"
+	mockController          = "alluxioruntime-controller"
+	mockControllerImage     = "fluidcloudnative/alluxioruntime-controller:v1.0.0"
+)
+
+// GetDeployment returns the mock AlluxioRuntime controller, with no ready
+// replica in the controller-down scenario
+func (m *MockClient) GetDeployment(ctx context.Context, namespace, name string) (*appsv1.Deployment, error) {
+	if namespace != mockControllerNamespace || name != mockController {
+		return nil, apierrors.NewNotFound(appsv1.Resource("deployments"), name)
+	}
+
+	replicas, ready := int32(1), int32(1)
+	if m.Scenario == ScenarioControllerDown {
+		ready = 0
+	}
+	return &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              mockController,
+			Namespace:         mockControllerNamespace,
+			UID:               types.UID("mock-uid-" + mockController),
+			Labels:            map[string]string{"control-plane": mockController},
+			CreationTimestamp: metav1.Time{Time: time.Now().Add(-72 * time.Hour)},
+		},
+		Spec: appsv1.DeploymentSpec{
+			Replicas: &replicas,
+			Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"control-plane": mockController}},
+			Template: corev1.PodTemplateSpec{
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{{Name: "main", Image: mockControllerImage}},
+				},
+			},
+		},
+		Status: appsv1.DeploymentStatus{
+			Replicas:            replicas,
+			ReadyReplicas:       ready,
+			AvailableReplicas:   ready,
+			UnavailableReplicas: replicas - ready,
+		},
+	}, nil
+}
+
+// ListServices returns the mock master Service
+func (m *MockClient) ListServices(ctx context.Context, namespace string, labelSelector string) (*corev1.ServiceList, error) {
+	list := &corev1.ServiceList{}
+	releaseName := mockReleaseName(namespace, labelSelector)
+
+	svc := corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      releaseName + "-master-0",
+			Namespace: namespace,
+			Labels: map[string]string{
+				"release": releaseName,
+				"app":     "alluxio",
+				"role":    "alluxio-master",
+			},
+			CreationTimestamp: metav1.Time{Time: time.Now().Add(-24 * time.Hour)},
+			OwnerReferences: []metav1.OwnerReference{
+				{
+					APIVersion: "data.fluid.io/v1alpha1",
+					Kind:       "AlluxioRuntime",
+					Name:       releaseName,
+					UID:        "mock-uid-runtime",
+				},
+			},
+		},
+		Spec: corev1.ServiceSpec{
+			ClusterIP: corev1.ClusterIPNone,
+			Selector: map[string]string{
+				"release": releaseName,
+				"role":    "alluxio-master",
+			},
+		},
+	}
+	list.Items = append(list.Items, svc)
+
+	var err error
+	list.Items, err = selectMockItems(m, list.Items, namespace, labelSelector)
+	return list, err
+}
+
+// GetEndpoints returns mock Endpoints, empty in the no-endpoints scenario
+func (m *MockClient) GetEndpoints(ctx context.Context, namespace, serviceName string) (*corev1.Endpoints, error) {
+	endpoints := &corev1.Endpoints{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      serviceName,
+			Namespace: namespace,
+		},
+	}
+	if m.Scenario != ScenarioNoEndpoints {
+		endpoints.Subsets = []corev1.EndpointSubset{{
+			Addresses: []corev1.EndpointAddress{{IP: "10.0.0.10"}},
+		}}
+	}
+	return endpoints, nil
 }
 
 // ListPVCs returns mock PVC list
 func (m *MockClient) ListPVCs(ctx context.Context, namespace string, labelSelector string) (*corev1.PersistentVolumeClaimList, error) {
 	list := &corev1.PersistentVolumeClaimList{}
-	releaseName := "demo-data"
+	releaseName := mockReleaseName(namespace, labelSelector)
 
 	pvc := createMockPVC(releaseName, namespace, releaseName)
+	if m.Scenario == ScenarioTerminating {
+		// Kept by pvc-protection until no pod mounts it
+		markMockTerminating(&pvc, "kubernetes.io/pvc-protection")
+	}
 	list.Items = append(list.Items, pvc)
 
-	return list, nil
+	var err error
+	list.Items, err = selectMockItems(m, list.Items, namespace, labelSelector)
+	return list, err
 }
 
 // GetPV returns a mock PV
@@ -282,23 +552,40 @@ func (m *MockClient) ListPVs(ctx context.Context, labelSelector string) (*corev1
 	return list, nil
 }
 
+// GetStorageClass returns the mock Fluid StorageClass
+func (m *MockClient) GetStorageClass(ctx context.Context, name string) (*storagev1.StorageClass, error) {
+	bindingMode := storagev1.VolumeBindingImmediate
+	reclaimPolicy := corev1.PersistentVolumeReclaimRetain
+	return &storagev1.StorageClass{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              name,
+			CreationTimestamp: metav1.Time{Time: time.Now().Add(-30 * 24 * time.Hour)},
+		},
+		Provisioner:       "fuse.csi.fluid.io",
+		ReclaimPolicy:     &reclaimPolicy,
+		VolumeBindingMode: &bindingMode,
+	}, nil
+}
+
 // ListConfigMaps returns mock ConfigMap list
 func (m *MockClient) ListConfigMaps(ctx context.Context, namespace string, labelSelector string) (*corev1.ConfigMapList, error) {
 	list := &corev1.ConfigMapList{}
-	releaseName := "demo-data"
+	releaseName := mockReleaseName(namespace, labelSelector)
 
 	for _, suffix := range []string{"config", "master-config", "worker-config"} {
 		cm := createMockConfigMap(releaseName+"-"+suffix, namespace, releaseName)
 		list.Items = append(list.Items, cm)
 	}
 
-	return list, nil
+	var err error
+	list.Items, err = selectMockItems(m, list.Items, namespace, labelSelector)
+	return list, err
 }
 
 // ListSecrets returns mock Secret list
 func (m *MockClient) ListSecrets(ctx context.Context, namespace string, labelSelector string) (*corev1.SecretList, error) {
 	list := &corev1.SecretList{}
-	releaseName := "demo-data"
+	releaseName := mockReleaseName(namespace, labelSelector)
 
 	secret := corev1.Secret{
 		ObjectMeta: metav1.ObjectMeta{
@@ -314,9 +601,380 @@ func (m *MockClient) ListSecrets(ctx context.Context, namespace string, labelSel
 	}
 	list.Items = append(list.Items, secret)
 
+	var err error
+	list.Items, err = selectMockItems(m, list.Items, namespace, labelSelector)
+	return list, err
+}
+
+// GetDataLoads returns a mock cache-warming DataLoad targeting the dataset
+func (m *MockClient) GetDataLoads(ctx context.Context, datasetName, namespace string) (*unstructured.UnstructuredList, error) {
+	list := &unstructured.UnstructuredList{}
+	list.SetAPIVersion("data.fluid.io/v1alpha1")
+	list.SetKind("DataLoadList")
+
+	spec := map[string]interface{}{
+		"dataset": mockDatasetRef(datasetName, namespace),
+	}
+	switch m.Scenario {
+	case ScenarioMissingRuntime:
+		// Nothing to load without a runtime
+	case ScenarioPartialReady:
+		list.Items = append(list.Items, *createMockDataOperation("DataLoad", datasetName+"-warmup", namespace, spec, "Executing", ""))
+	default:
+		list.Items = append(list.Items, *createMockDataOperation("DataLoad", datasetName+"-warmup", namespace, spec, "Complete", "4m12s"))
+	}
+
+	return list, nil
+}
+
+// GetDataBackups returns a mock completed DataBackup of the dataset
+func (m *MockClient) GetDataBackups(ctx context.Context, datasetName, namespace string) (*unstructured.UnstructuredList, error) {
+	list := &unstructured.UnstructuredList{}
+	list.SetAPIVersion("data.fluid.io/v1alpha1")
+	list.SetKind("DataBackupList")
+
+	if m.Scenario != ScenarioMissingRuntime {
+		spec := map[string]interface{}{
+			"dataset":    datasetName,
+			"backupPath": "pvc://backup-pvc/" + datasetName,
+		}
+		list.Items = append(list.Items, *createMockDataOperation("DataBackup", datasetName+"-backup", namespace, spec, "Complete", "1m3s"))
+	}
+
+	return list, nil
+}
+
+// GetDataMigrates returns a mock DataMigrate into the dataset, running in the partial-ready scenario
+func (m *MockClient) GetDataMigrates(ctx context.Context, datasetName, namespace string) (*unstructured.UnstructuredList, error) {
+	list := &unstructured.UnstructuredList{}
+	list.SetAPIVersion("data.fluid.io/v1alpha1")
+	list.SetKind("DataMigrateList")
+
+	if m.Scenario == ScenarioPartialReady {
+		spec := map[string]interface{}{
+			"from": map[string]interface{}{
+				"externalStorage": map[string]interface{}{
+					"uri": "s3://demo-bucket/" + datasetName,
+				},
+			},
+			"to": map[string]interface{}{
+				"dataset": mockDatasetRef(datasetName, namespace),
+			},
+		}
+		list.Items = append(list.Items, *createMockDataOperation("DataMigrate", datasetName+"-migrate", namespace, spec, "Executing", ""))
+	}
+
 	return list, nil
 }
 
+// ListHorizontalPodAutoscalers returns a mock HPA scaling the worker StatefulSet
+// between 2 and 4 replicas; in the scaling scenario it is asking for a third
+func (m *MockClient) ListHorizontalPodAutoscalers(ctx context.Context, namespace string) (*autoscalingv2.HorizontalPodAutoscalerList, error) {
+	list := &autoscalingv2.HorizontalPodAutoscalerList{}
+	if m.Scenario == ScenarioMissingRuntime {
+		return list, nil
+	}
+
+	release := mockReleaseName(namespace, "")
+	minReplicas, desired := int32(2), int32(2)
+	if m.Scenario == ScenarioScaling {
+		desired = 3
+	}
+	list.Items = append(list.Items, autoscalingv2.HorizontalPodAutoscaler{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              release + "-worker-hpa",
+			Namespace:         namespace,
+			CreationTimestamp: metav1.Time{Time: time.Now().Add(-24 * time.Hour)},
+		},
+		Spec: autoscalingv2.HorizontalPodAutoscalerSpec{
+			ScaleTargetRef: autoscalingv2.CrossVersionObjectReference{
+				APIVersion: "apps/v1",
+				Kind:       "StatefulSet",
+				Name:       release + "-worker",
+			},
+			MinReplicas: &minReplicas,
+			MaxReplicas: 4,
+		},
+		Status: autoscalingv2.HorizontalPodAutoscalerStatus{
+			CurrentReplicas: 2,
+			DesiredReplicas: desired,
+		},
+	})
+
+	return list, nil
+}
+
+// ListEvents returns mock Events explaining why worker pods are not running
+func (m *MockClient) ListEvents(ctx context.Context, namespace, involvedObjectName string) (*corev1.EventList, error) {
+	list := &corev1.EventList{}
+
+	switch m.Scenario {
+	case ScenarioPartialReady:
+		list.Items = append(list.Items,
+			createMockEvent(involvedObjectName, namespace, "FailedScheduling",
+				"0/3 nodes are available: 3 Insufficient memory.", 10*time.Minute),
+		)
+	case ScenarioFailedPods:
+		list.Items = append(list.Items,
+			createMockEvent(involvedObjectName, namespace, "Failed",
+				"Error: failed to create containerd task: OOMKilled", 20*time.Minute),
+			createMockEvent(involvedObjectName, namespace, "BackOff",
+				"Back-off restarting failed container", 5*time.Minute),
+		)
+	}
+
+	return list, nil
+}
+
+// CountResources counts the mock objects the list methods return
+func (m *MockClient) CountResources(ctx context.Context, namespace, kind, labelSelector string) (int, error) {
+	objs, err := m.mockObjects(ctx, namespace, kind, labelSelector)
+	return len(objs), err
+}
+
+// GetObjectMeta finds a mock object the list methods return by name
+func (m *MockClient) GetObjectMeta(ctx context.Context, namespace, kind, name string) (metav1.Object, error) {
+	objs, err := m.mockObjects(ctx, namespace, kind, "")
+	if err != nil {
+		return nil, err
+	}
+	for _, obj := range objs {
+		if obj.GetName() == name {
+			return obj, nil
+		}
+	}
+	return nil, apierrors.NewNotFound(namespacedKinds[kind].GroupResource(), name)
+}
+
+// mockObjects returns the metadata of the mock objects of a kind matching the label selector
+func (m *MockClient) mockObjects(ctx context.Context, namespace, kind, labelSelector string) ([]metav1.Object, error) {
+	var objs []metav1.Object
+	switch kind {
+	case "StatefulSet":
+		list, err := m.ListStatefulSets(ctx, namespace, labelSelector)
+		if err != nil {
+			return nil, err
+		}
+		for i := range list.Items {
+			objs = append(objs, &list.Items[i])
+		}
+	case "DaemonSet":
+		list, err := m.ListDaemonSets(ctx, namespace, labelSelector)
+		if err != nil {
+			return nil, err
+		}
+		for i := range list.Items {
+			objs = append(objs, &list.Items[i])
+		}
+	case "Pod":
+		list, err := m.ListPods(ctx, namespace, labelSelector, "")
+		if err != nil {
+			return nil, err
+		}
+		for i := range list.Items {
+			objs = append(objs, &list.Items[i])
+		}
+	case "Service":
+		list, err := m.ListServices(ctx, namespace, labelSelector)
+		if err != nil {
+			return nil, err
+		}
+		for i := range list.Items {
+			objs = append(objs, &list.Items[i])
+		}
+	case "PersistentVolumeClaim":
+		list, err := m.ListPVCs(ctx, namespace, labelSelector)
+		if err != nil {
+			return nil, err
+		}
+		for i := range list.Items {
+			objs = append(objs, &list.Items[i])
+		}
+	case "ConfigMap":
+		list, err := m.ListConfigMaps(ctx, namespace, labelSelector)
+		if err != nil {
+			return nil, err
+		}
+		for i := range list.Items {
+			objs = append(objs, &list.Items[i])
+		}
+	case "Secret":
+		list, err := m.ListSecrets(ctx, namespace, labelSelector)
+		if err != nil {
+			return nil, err
+		}
+		for i := range list.Items {
+			objs = append(objs, &list.Items[i])
+		}
+	default:
+		return nil, fmt.Errorf("unsupported kind %s", kind)
+	}
+	return objs, nil
+}
+
+// ListPodMetrics returns mock metrics-server usage for the running mock pods
+func (m *MockClient) ListPodMetrics(ctx context.Context, namespace string, labelSelector string) (*unstructured.UnstructuredList, error) {
+	pods, err := m.ListPods(ctx, namespace, labelSelector, "")
+	if err != nil {
+		return nil, err
+	}
+
+	list := &unstructured.UnstructuredList{}
+	list.SetAPIVersion("metrics.k8s.io/v1beta1")
+	list.SetKind("PodMetricsList")
+	for _, pod := range pods.Items {
+		if pod.Status.Phase != corev1.PodRunning {
+			continue
+		}
+		cpu, memory := mockPodUsage(pod.Labels["role"])
+
+		metrics := unstructured.Unstructured{}
+		metrics.SetAPIVersion("metrics.k8s.io/v1beta1")
+		metrics.SetKind("PodMetrics")
+		metrics.SetName(pod.Name)
+		metrics.SetNamespace(pod.Namespace)
+		metrics.Object["containers"] = []interface{}{
+			map[string]interface{}{
+				"name": "main",
+				"usage": map[string]interface{}{
+					"cpu":    cpu,
+					"memory": memory,
+				},
+			},
+		}
+		list.Items = append(list.Items, metrics)
+	}
+	return list, nil
+}
+
+// mockPodUsage returns the live CPU and memory usage reported for a mock pod role
+func mockPodUsage(role string) (string, string) {
+	switch role {
+	case "alluxio-master":
+		return "210m", "870Mi"
+	case "alluxio-worker":
+		return "640m", "3650Mi"
+	default:
+		return "95m", "310Mi"
+	}
+}
+
+// mockPodResources returns the container requests and limits of a mock pod role
+func mockPodResources(role string) corev1.ResourceRequirements {
+	cpuRequest, cpuLimit, memRequest, memLimit := "250m", "1", "256Mi", "1Gi"
+	switch role {
+	case "alluxio-master":
+		cpuRequest, cpuLimit, memRequest, memLimit = "500m", "1", "1Gi", "2Gi"
+	case "alluxio-worker":
+		cpuRequest, cpuLimit, memRequest, memLimit = "1", "2", "2Gi", "4Gi"
+	}
+	return corev1.ResourceRequirements{
+		Requests: corev1.ResourceList{
+			corev1.ResourceCPU:    resource.MustParse(cpuRequest),
+			corev1.ResourceMemory: resource.MustParse(memRequest),
+		},
+		Limits: corev1.ResourceList{
+			corev1.ResourceCPU:    resource.MustParse(cpuLimit),
+			corev1.ResourceMemory: resource.MustParse(memLimit),
+		},
+	}
+}
+
+// mockImage returns the image the mock runtime runs for a role
+func mockImage(role string) string {
+	switch role {
+	case "alluxio-master", "alluxio-worker":
+		return "alluxio/alluxio:2.9.0"
+	case "alluxio-fuse":
+		return "alluxio/alluxio-fuse:2.9.0"
+	}
+	return ""
+}
+
+// mockPodTemplate returns a workload's pod template running the role's image
+func mockPodTemplate(role string) corev1.PodTemplateSpec {
+	return corev1.PodTemplateSpec{
+		Spec: corev1.PodSpec{
+			Containers: []corev1.Container{{Name: "main", Image: mockImage(role)}},
+		},
+	}
+}
+
+// mockDatasetLabel is the label newer Fluid releases put on runtime resources
+const mockDatasetLabel = "fluid.io/dataset"
+
+// mockReleaseName derives the release name from a "release=<name>" or
+// "fluid.io/dataset=<namespace>-<name>" selector, defaulting to demo-data
+func mockReleaseName(namespace, labelSelector string) string {
+	selector, err := labels.Parse(labelSelector)
+	if err == nil {
+		requirements, _ := selector.Requirements()
+		for _, req := range requirements {
+			value, ok := req.Values().PopAny()
+			if !ok || req.Operator() != selection.Equals {
+				continue
+			}
+			switch req.Key() {
+			case "release":
+				return value
+			case mockDatasetLabel:
+				return strings.TrimPrefix(value, namespace+"-")
+			}
+		}
+	}
+	return "demo-data"
+}
+
+// markMockTerminating sets a deletion timestamp mockDeletionAge ago and the
+// finalizers holding the object back
+func markMockTerminating(obj metav1.Object, finalizers ...string) {
+	deleted := metav1.NewTime(time.Now().Add(-mockDeletionAge))
+	grace := int64(30)
+	obj.SetDeletionTimestamp(&deleted)
+	obj.SetDeletionGracePeriodSeconds(&grace)
+	obj.SetFinalizers(append(obj.GetFinalizers(), finalizers...))
+}
+
+// selectMockItems applies the scenario's labeling scheme to each item and
+// keeps only the items matching the label selector
+func selectMockItems[T any](m *MockClient, items []T, namespace, labelSelector string) ([]T, error) {
+	selector, err := labels.Parse(labelSelector)
+	if err != nil {
+		return nil, fmt.Errorf("invalid label selector %q: %w", labelSelector, err)
+	}
+
+	var selected []T
+	for i := range items {
+		obj := any(&items[i]).(metav1.Object)
+		if m.Scenario == ScenarioDatasetLabel {
+			objLabels := obj.GetLabels()
+			if release, ok := objLabels["release"]; ok {
+				delete(objLabels, "release")
+				objLabels[mockDatasetLabel] = namespace + "-" + release
+			}
+		}
+		if m.Scenario == ScenarioAppLabelOnly && obj.GetLabels()["role"] == "alluxio-worker" {
+			delete(obj.GetLabels(), "release")
+		}
+		// The deleted Runtime's worker StatefulSet waits on its pods (foreground deletion)
+		if m.Scenario == ScenarioTerminating && obj.GetLabels()["role"] == "alluxio-worker" && obj.GetDeletionTimestamp() == nil {
+			if _, ok := obj.(*appsv1.StatefulSet); ok {
+				markMockTerminating(obj, metav1.FinalizerDeleteDependents)
+			} else {
+				markMockTerminating(obj)
+			}
+		}
+		// Without a running controller, no runtime component was ever created
+		if m.Scenario == ScenarioControllerDown && strings.HasPrefix(obj.GetLabels()["role"], "alluxio-") {
+			continue
+		}
+		if selector.Matches(labels.Set(obj.GetLabels())) {
+			selected = append(selected, items[i])
+		}
+	}
+	return selected, nil
+}
+
 // Helper functions to create mock resources
 
 func createMockDataset(name, namespace, phase string, runtimes []interface{}) *unstructured.Unstructured {
@@ -332,8 +990,21 @@ func createMockDataset(name, namespace, phase string, runtimes []interface{}) *u
 			map[string]interface{}{
 				"mountPoint": "s3://example-bucket/data",
 				"name":       "data",
+				"path":       "/data",
+				"options": map[string]interface{}{
+					"alluxio.underfs.s3.endpoint": "s3.us-east-1.amazonaws.com",
+				},
+			},
+			map[string]interface{}{
+				"mountPoint": "s3://example-bucket/checkpoints",
+				"name":       "checkpoints",
+				"path":       "/checkpoints",
 			},
 		},
+		"accessModes": []interface{}{"ReadOnlyMany"},
+		"sharedOptions": map[string]interface{}{
+			"alluxio.user.file.readtype.default": "CACHE",
+		},
 	}
 
 	status := map[string]interface{}{
@@ -372,6 +1043,7 @@ func createMockStatefulSet(name, namespace, release, role string, replicas, read
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      name,
 			Namespace: namespace,
+			UID:       types.UID("mock-uid-" + name),
 			Labels: map[string]string{
 				"release": release,
 				"app":     "alluxio",
@@ -389,10 +1061,14 @@ func createMockStatefulSet(name, namespace, release, role string, replicas, read
 		},
 		Spec: appsv1.StatefulSetSpec{
 			Replicas: &replicas,
+			Template: mockPodTemplate(role),
 		},
 		Status: appsv1.StatefulSetStatus{
-			Replicas:      replicas,
-			ReadyReplicas: ready,
+			Replicas:        replicas,
+			ReadyReplicas:   ready,
+			UpdatedReplicas: replicas,
+			CurrentRevision: name + "-" + mockCurrentRevision,
+			UpdateRevision:  name + "-" + mockCurrentRevision,
 		},
 	}
 }
@@ -402,6 +1078,7 @@ func createMockDaemonSet(name, namespace, release, role string, desired, ready i
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      name,
 			Namespace: namespace,
+			UID:       types.UID("mock-uid-" + name),
 			Labels: map[string]string{
 				"release": release,
 				"app":     "alluxio",
@@ -417,6 +1094,9 @@ func createMockDaemonSet(name, namespace, release, role string, desired, ready i
 				},
 			},
 		},
+		Spec: appsv1.DaemonSetSpec{
+			Template: mockPodTemplate(role),
+		},
 		Status: appsv1.DaemonSetStatus{
 			DesiredNumberScheduled: desired,
 			NumberReady:            ready,
@@ -448,6 +1128,15 @@ func createMockPod(name, namespace, release, role string, phase corev1.PodPhase)
 			},
 			CreationTimestamp: metav1.Time{Time: time.Now().Add(-1 * time.Hour)},
 		},
+		Spec: corev1.PodSpec{
+			Containers: []corev1.Container{
+				{
+					Name:      "main",
+					Image:     mockImage(role),
+					Resources: mockPodResources(role),
+				},
+			},
+		},
 		Status: corev1.PodStatus{
 			Phase:             phase,
 			ContainerStatuses: []corev1.ContainerStatus{containerStatus},
@@ -455,7 +1144,106 @@ func createMockPod(name, namespace, release, role string, phase corev1.PodPhase)
 	}
 }
 
+// createMockControllerPod creates the runtime controller's pod, crash-looping when down
+func createMockControllerPod(down bool) corev1.Pod {
+	pod := createMockPod(mockController+"-5f7c9d8b4-x2k9q", mockControllerNamespace, "", "", corev1.PodRunning)
+	pod.Labels = map[string]string{"control-plane": mockController}
+	pod.Spec.Containers[0].Image = mockControllerImage
+	pod.OwnerReferences = mockOwnerReferences("ReplicaSet", mockController+"-5f7c9d8b4")
+	pod.Spec.NodeName = mockNodeName(0)
+	if down {
+		pod.Status.ContainerStatuses[0] = corev1.ContainerStatus{
+			Name:         "main",
+			RestartCount: 14,
+			State: corev1.ContainerState{
+				Waiting: &corev1.ContainerStateWaiting{Reason: "CrashLoopBackOff"},
+			},
+		}
+	}
+	return pod
+}
+
+// createMockSidecarPod creates an application pod mounting the dataset through
+// an injected fuse sidecar. It carries no release label, so only the webhook's
+// inject label selects it.
+func createMockSidecarPod(name, namespace, dataset string) corev1.Pod {
+	pod := createMockPod(name, namespace, dataset, "app", corev1.PodRunning)
+	pod.Labels = map[string]string{
+		"app":                        "training",
+		"serverless.fluid.io/inject": "true",
+	}
+	pod.Spec.Containers = append(pod.Spec.Containers, corev1.Container{Name: "fluid-fuse-0"})
+	pod.Spec.Volumes = []corev1.Volume{{
+		Name: "data",
+		VolumeSource: corev1.VolumeSource{
+			HostPath: &corev1.HostPathVolumeSource{Path: fmt.Sprintf("/runtime-mnt/alluxio/%s/%s/alluxio-fuse", namespace, dataset)},
+		},
+	}}
+	return pod
+}
+
+// createMockConsumerPod creates an application pod mounting the Dataset's PVC
+func createMockConsumerPod(name, namespace, dataset string) corev1.Pod {
+	pod := createMockPod(name, namespace, dataset, "app", corev1.PodRunning)
+	pod.Labels = map[string]string{"app": "training"}
+	pod.Spec.Containers[0].Image = "pytorch/pytorch:2.1.0-cuda12.1-cudnn8-runtime"
+	pod.Spec.NodeName = mockNodeName(1)
+	pod.Spec.Volumes = []corev1.Volume{{
+		Name: "data",
+		VolumeSource: corev1.VolumeSource{
+			PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{ClaimName: dataset},
+		},
+	}}
+	return pod
+}
+
+func mockDatasetRef(name, namespace string) map[string]interface{} {
+	return map[string]interface{}{
+		"name":      name,
+		"namespace": namespace,
+	}
+}
+
+func createMockDataOperation(kind, name, namespace string, spec map[string]interface{}, phase, duration string) *unstructured.Unstructured {
+	op := &unstructured.Unstructured{}
+	op.SetAPIVersion("data.fluid.io/v1alpha1")
+	op.SetKind(kind)
+	op.SetName(name)
+	op.SetNamespace(namespace)
+	op.SetCreationTimestamp(metav1.Time{Time: time.Now().Add(-30 * time.Minute)})
+
+	op.Object["spec"] = spec
+	status := map[string]interface{}{
+		"phase": phase,
+	}
+	if duration != "" {
+		status["duration"] = duration
+	}
+	op.Object["status"] = status
+
+	return op
+}
+
+func createMockEvent(involvedObjectName, namespace, reason, message string, ago time.Duration) corev1.Event {
+	return corev1.Event{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      fmt.Sprintf("%s.%s", involvedObjectName, strings.ToLower(reason)),
+			Namespace: namespace,
+		},
+		InvolvedObject: corev1.ObjectReference{
+			Kind:      "Pod",
+			Name:      involvedObjectName,
+			Namespace: namespace,
+		},
+		Reason:        reason,
+		Message:       message,
+		Type:          corev1.EventTypeWarning,
+		LastTimestamp: metav1.Time{Time: time.Now().Add(-ago)},
+	}
+}
+
 func createMockPVC(name, namespace, release string) corev1.PersistentVolumeClaim {
+	storageClass := "fluid"
 	return corev1.PersistentVolumeClaim{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      name,
@@ -466,7 +1254,9 @@ func createMockPVC(name, namespace, release string) corev1.PersistentVolumeClaim
 			CreationTimestamp: metav1.Time{Time: time.Now().Add(-24 * time.Hour)},
 		},
 		Spec: corev1.PersistentVolumeClaimSpec{
-			VolumeName: name + "-pv",
+			VolumeName:       name + "-pv",
+			AccessModes:      []corev1.PersistentVolumeAccessMode{corev1.ReadOnlyMany},
+			StorageClassName: &storageClass,
 			Resources: corev1.VolumeResourceRequirements{
 				Requests: corev1.ResourceList{
 					corev1.ResourceStorage: resource.MustParse("100Gi"),
@@ -474,12 +1264,22 @@ func createMockPVC(name, namespace, release string) corev1.PersistentVolumeClaim
 			},
 		},
 		Status: corev1.PersistentVolumeClaimStatus{
-			Phase: corev1.ClaimBound,
+			Phase:       corev1.ClaimBound,
+			AccessModes: []corev1.PersistentVolumeAccessMode{corev1.ReadOnlyMany},
+			Capacity: corev1.ResourceList{
+				corev1.ResourceStorage: resource.MustParse("100Gi"),
+			},
 		},
 	}
 }
 
 func createMockPV(name string) corev1.PersistentVolume {
+	// The claim's namespace is not known here; the mapper accepts an empty one
+	claimRef := &corev1.ObjectReference{
+		Kind:       "PersistentVolumeClaim",
+		APIVersion: "v1",
+		Name:       strings.TrimSuffix(name, "-pv"),
+	}
 	return corev1.PersistentVolume{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:              name,
@@ -489,6 +1289,10 @@ func createMockPV(name string) corev1.PersistentVolume {
 			Capacity: corev1.ResourceList{
 				corev1.ResourceStorage: resource.MustParse("100Gi"),
 			},
+			AccessModes:                   []corev1.PersistentVolumeAccessMode{corev1.ReadOnlyMany},
+			PersistentVolumeReclaimPolicy: corev1.PersistentVolumeReclaimRetain,
+			StorageClassName:              "fluid",
+			ClaimRef:                      claimRef,
 		},
 		Status: corev1.PersistentVolumeStatus{
 			Phase: corev1.VolumeBound,
@@ -513,7 +1317,40 @@ func createMockConfigMap(name, namespace, release string) corev1.ConfigMap {
 	}
 }
 
-func generateHash(i int) string {
-	hashes := []string{"a1b2c", "d3e4f", "g5h6i", "j7k8l", "m9n0p"}
-	return hashes[i%len(hashes)]
+func mockOwnerReferences(kind, name string) []metav1.OwnerReference {
+	return []metav1.OwnerReference{
+		{
+			APIVersion: "apps/v1",
+			Kind:       kind,
+			Name:       name,
+			UID:        types.UID("mock-uid-" + name),
+		},
+	}
+}
+
+// mockNodeName returns the name of the i-th mock node. Workers land on nodes 1
+// and 2 while fuse pods run on nodes 0-2, so node 0 serves fuse without a
+// local worker.
+func mockNodeName(i int) string {
+	return fmt.Sprintf("node-%d", i)
+}
+
+// mockPodSuffixAlphabet is the alphabet Kubernetes draws generated name
+// suffixes from (no vowels, no ambiguous characters)
+const mockPodSuffixAlphabet = "bcdfghjklmnpqrstvwxz2456789"
+
+// mockPodSuffix returns the 5-character suffix of the i-th DaemonSet pod of a
+// release. It is derived from an FNV hash of the release name and index, so
+// names are stable across runs and fuse counts and distinct per index.
+func mockPodSuffix(releaseName string, i int) string {
+	h := fnv.New32a()
+	fmt.Fprintf(h, "%s/%d", releaseName, i)
+	sum := h.Sum32()
+
+	suffix := make([]byte, 5)
+	for j := range suffix {
+		suffix[j] = mockPodSuffixAlphabet[sum%uint32(len(mockPodSuffixAlphabet))]
+		sum /= uint32(len(mockPodSuffixAlphabet))
+	}
+	return string(suffix)
 }
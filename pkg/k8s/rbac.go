@@ -0,0 +1,94 @@
+// Package k8s RBAC preflight checks
+package k8s
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	authorizationv1 "k8s.io/api/authorization/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// Permission is an API verb on a resource that the mapper needs
+type Permission struct {
+	Verb     string
+	Group    string
+	Resource string
+
+	// ClusterScoped permissions are checked without a namespace
+	ClusterScoped bool
+}
+
+// String formats the permission like kubectl auth can-i, e.g. "list statefulsets.apps"
+func (p Permission) String() string {
+	if p.Group == "" {
+		return p.Verb + " " + p.Resource
+	}
+	return p.Verb + " " + p.Resource + "." + p.Group
+}
+
+// RequiredPermissions returns the permissions used during discovery. Pod
+// metrics are only needed when usage is requested.
+func RequiredPermissions(withMetrics bool) []Permission {
+	permissions := []Permission{
+		{Verb: "get", Group: FluidAPIGroup, Resource: DatasetGVR.Resource},
+		{Verb: "list", Group: FluidAPIGroup, Resource: DatasetGVR.Resource},
+		{Verb: "list", Group: "apps", Resource: "statefulsets"},
+		{Verb: "list", Group: "apps", Resource: "daemonsets"},
+		{Verb: "list", Resource: "pods"},
+		{Verb: "list", Resource: "services"},
+		{Verb: "get", Resource: "endpoints"},
+		{Verb: "list", Resource: "persistentvolumeclaims"},
+		{Verb: "get", Resource: "persistentvolumes", ClusterScoped: true},
+		{Verb: "list", Resource: "persistentvolumes", ClusterScoped: true},
+		{Verb: "list", Resource: "configmaps"},
+		{Verb: "list", Resource: "secrets"},
+		{Verb: "list", Resource: "events"},
+		{Verb: "list", Group: FluidAPIGroup, Resource: DataLoadGVR.Resource},
+		{Verb: "list", Group: FluidAPIGroup, Resource: DataBackupGVR.Resource},
+		{Verb: "list", Group: FluidAPIGroup, Resource: DataMigrateGVR.Resource},
+	}
+
+	runtimeResources := make([]string, 0, len(RuntimeTypeToGVR))
+	for _, gvr := range RuntimeTypeToGVR {
+		runtimeResources = append(runtimeResources, gvr.Resource)
+	}
+	sort.Strings(runtimeResources)
+	for _, resource := range runtimeResources {
+		permissions = append(permissions, Permission{Verb: "get", Group: FluidAPIGroup, Resource: resource})
+	}
+
+	if withMetrics {
+		permissions = append(permissions, Permission{Verb: "list", Group: PodMetricsGVR.Group, Resource: PodMetricsGVR.Resource})
+	}
+	return permissions
+}
+
+// MissingPermissions asks the API server, with a SelfSubjectAccessReview per
+// permission, which of the permissions the client's identity lacks in the
+// namespace. An empty namespace checks cluster-wide access.
+func (c *RealClient) MissingPermissions(ctx context.Context, namespace string, permissions []Permission) ([]Permission, error) {
+	var missing []Permission
+	for _, p := range permissions {
+		attributes := &authorizationv1.ResourceAttributes{
+			Verb:     p.Verb,
+			Group:    p.Group,
+			Resource: p.Resource,
+		}
+		if !p.ClusterScoped {
+			attributes.Namespace = namespace
+		}
+
+		review, err := c.clientset.AuthorizationV1().SelfSubjectAccessReviews().Create(ctx, &authorizationv1.SelfSubjectAccessReview{
+			Spec: authorizationv1.SelfSubjectAccessReviewSpec{ResourceAttributes: attributes},
+		}, metav1.CreateOptions{})
+		if err != nil {
+			return nil, fmt.Errorf("failed to review access to %s: %w", p, err)
+		}
+		if !review.Status.Allowed {
+			missing = append(missing, p)
+		}
+	}
+	return missing, nil
+}
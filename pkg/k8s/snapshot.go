@@ -0,0 +1,416 @@
+// Package k8s snapshot client implementation for offline analysis of
+// resource dumps taken from a real cluster.
+package k8s
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	corev1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	utilyaml "k8s.io/apimachinery/pkg/util/yaml"
+)
+
+// runtimeTypeToKind maps runtime type strings to their CRD kinds
+var runtimeTypeToKind = map[string]string{
+	"alluxio":  "AlluxioRuntime",
+	"jindo":    "JindoRuntime",
+	"juicefs":  "JuiceFSRuntime",
+	"goosefs":  "GooseFSRuntime",
+	"vineyard": "VineyardRuntime",
+	"efc":      "EFCRuntime",
+	"thin":     "ThinRuntime",
+}
+
+// SnapshotClient implements the Client interface from a directory of
+// resource dumps, e.g. the output of `kubectl get -o yaml`. Every object
+// is loaded once up front; lookups filter them in memory the way the API
+// server would.
+type SnapshotClient struct {
+	dir     string
+	objects []unstructured.Unstructured
+}
+
+// NewSnapshotClient loads every .yaml, .yml and .json file under dir.
+// Files may hold several documents separated by "---" as well as List
+// objects, which are unwrapped into their items.
+func NewSnapshotClient(dir string) (*SnapshotClient, error) {
+	c := &SnapshotClient{dir: dir}
+
+	err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			return nil
+		}
+		switch strings.ToLower(filepath.Ext(path)) {
+		case ".yaml", ".yml", ".json":
+		default:
+			return nil
+		}
+		objects, err := loadSnapshotFile(path)
+		if err != nil {
+			return fmt.Errorf("failed to load %s: %w", path, err)
+		}
+		c.objects = append(c.objects, objects...)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(c.objects) == 0 {
+		return nil, fmt.Errorf("no Kubernetes objects found under %s", dir)
+	}
+	return c, nil
+}
+
+// loadSnapshotFile decodes all objects in a YAML or JSON file
+func loadSnapshotFile(path string) ([]unstructured.Unstructured, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var objects []unstructured.Unstructured
+	decoder := utilyaml.NewYAMLOrJSONDecoder(f, 4096)
+	for {
+		var doc map[string]interface{}
+		if err := decoder.Decode(&doc); err != nil {
+			if errors.Is(err, io.EOF) {
+				return objects, nil
+			}
+			return nil, err
+		}
+		if len(doc) == 0 {
+			continue
+		}
+
+		obj := unstructured.Unstructured{Object: doc}
+		if !obj.IsList() {
+			objects = append(objects, obj)
+			continue
+		}
+		err := obj.EachListItem(func(item runtime.Object) error {
+			objects = append(objects, *item.(*unstructured.Unstructured))
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+}
+
+// GetClusterName returns the snapshot directory name
+func (c *SnapshotClient) GetClusterName() string {
+	return "snapshot:" + filepath.Base(c.dir)
+}
+
+// GetDataset returns the Dataset with the given name from the snapshot
+func (c *SnapshotClient) GetDataset(ctx context.Context, name, namespace string) (*unstructured.Unstructured, error) {
+	return c.get(DatasetGVR.GroupResource(), "Dataset", name, namespace)
+}
+
+// ListDatasets returns the Datasets in a namespace, or all if namespace is empty
+func (c *SnapshotClient) ListDatasets(ctx context.Context, namespace string) (*unstructured.UnstructuredList, error) {
+	items, err := c.list("Dataset", namespace, "")
+	if err != nil {
+		return nil, err
+	}
+	return &unstructured.UnstructuredList{Items: items}, nil
+}
+
+// GetRuntime returns the Runtime of the given type and name from the snapshot
+func (c *SnapshotClient) GetRuntime(ctx context.Context, runtimeType, name, namespace string) (*unstructured.Unstructured, error) {
+	gvr, ok := RuntimeTypeToGVR[runtimeType]
+	if !ok {
+		return nil, fmt.Errorf("unknown runtime type: %s", runtimeType)
+	}
+	kind, ok := runtimeTypeToKind[runtimeType]
+	if !ok {
+		kind = c.kindForResource(gvr)
+	}
+	return c.get(gvr.GroupResource(), kind, name, namespace)
+}
+
+// GetThinRuntimeProfile returns the ThinRuntimeProfile with the given name from the snapshot
+func (c *SnapshotClient) GetThinRuntimeProfile(ctx context.Context, name string) (*unstructured.Unstructured, error) {
+	return c.get(ThinRuntimeProfileGVR.GroupResource(), "ThinRuntimeProfile", name, "")
+}
+
+// kindForResource infers the kind of a registered custom runtime from the
+// loaded objects: the kind in the GVR's group whose lowercase plural is the
+// resource, e.g. MyCacheRuntime for mycacheruntimes
+func (c *SnapshotClient) kindForResource(gvr schema.GroupVersionResource) string {
+	for i := range c.objects {
+		gvk := c.objects[i].GroupVersionKind()
+		if gvk.Group == gvr.Group && strings.ToLower(gvk.Kind)+"s" == gvr.Resource {
+			return gvk.Kind
+		}
+	}
+	return ""
+}
+
+// ListStatefulSets returns the StatefulSets matching the label selector
+func (c *SnapshotClient) ListStatefulSets(ctx context.Context, namespace string, labelSelector string) (*appsv1.StatefulSetList, error) {
+	items, err := listTyped[appsv1.StatefulSet](c, "StatefulSet", namespace, labelSelector)
+	if err != nil {
+		return nil, err
+	}
+	return &appsv1.StatefulSetList{Items: items}, nil
+}
+
+// ListDaemonSets returns the DaemonSets matching the label selector
+func (c *SnapshotClient) ListDaemonSets(ctx context.Context, namespace string, labelSelector string) (*appsv1.DaemonSetList, error) {
+	items, err := listTyped[appsv1.DaemonSet](c, "DaemonSet", namespace, labelSelector)
+	if err != nil {
+		return nil, err
+	}
+	return &appsv1.DaemonSetList{Items: items}, nil
+}
+
+// GetDeployment returns the Deployment with the given name from the snapshot
+func (c *SnapshotClient) GetDeployment(ctx context.Context, namespace, name string) (*appsv1.Deployment, error) {
+	obj, err := c.get(appsv1.Resource("deployments"), "Deployment", name, namespace)
+	if err != nil {
+		return nil, err
+	}
+	deployment := &appsv1.Deployment{}
+	return deployment, runtime.DefaultUnstructuredConverter.FromUnstructured(obj.Object, deployment)
+}
+
+// ListPods returns the Pods matching the label and field selectors
+func (c *SnapshotClient) ListPods(ctx context.Context, namespace string, labelSelector, fieldSelector string) (*corev1.PodList, error) {
+	items, err := listTyped[corev1.Pod](c, "Pod", namespace, labelSelector)
+	if err != nil {
+		return nil, err
+	}
+	if items, err = filterPodsByFields(items, fieldSelector); err != nil {
+		return nil, err
+	}
+	return &corev1.PodList{Items: items}, nil
+}
+
+// ListServices returns the Services matching the label selector
+func (c *SnapshotClient) ListServices(ctx context.Context, namespace string, labelSelector string) (*corev1.ServiceList, error) {
+	items, err := listTyped[corev1.Service](c, "Service", namespace, labelSelector)
+	if err != nil {
+		return nil, err
+	}
+	return &corev1.ServiceList{Items: items}, nil
+}
+
+// GetEndpoints returns the Endpoints of a Service from the snapshot
+func (c *SnapshotClient) GetEndpoints(ctx context.Context, namespace, serviceName string) (*corev1.Endpoints, error) {
+	obj, err := c.get(corev1.Resource("endpoints"), "Endpoints", serviceName, namespace)
+	if err != nil {
+		return nil, err
+	}
+	endpoints := &corev1.Endpoints{}
+	return endpoints, runtime.DefaultUnstructuredConverter.FromUnstructured(obj.Object, endpoints)
+}
+
+// ListPVCs returns the PersistentVolumeClaims matching the label selector
+func (c *SnapshotClient) ListPVCs(ctx context.Context, namespace string, labelSelector string) (*corev1.PersistentVolumeClaimList, error) {
+	items, err := listTyped[corev1.PersistentVolumeClaim](c, "PersistentVolumeClaim", namespace, labelSelector)
+	if err != nil {
+		return nil, err
+	}
+	return &corev1.PersistentVolumeClaimList{Items: items}, nil
+}
+
+// GetPV returns the PersistentVolume with the given name from the snapshot
+func (c *SnapshotClient) GetPV(ctx context.Context, name string) (*corev1.PersistentVolume, error) {
+	obj, err := c.get(corev1.Resource("persistentvolumes"), "PersistentVolume", name, "")
+	if err != nil {
+		return nil, err
+	}
+	pv := &corev1.PersistentVolume{}
+	return pv, runtime.DefaultUnstructuredConverter.FromUnstructured(obj.Object, pv)
+}
+
+// ListPVs returns the PersistentVolumes matching the label selector
+func (c *SnapshotClient) ListPVs(ctx context.Context, labelSelector string) (*corev1.PersistentVolumeList, error) {
+	items, err := listTyped[corev1.PersistentVolume](c, "PersistentVolume", "", labelSelector)
+	if err != nil {
+		return nil, err
+	}
+	return &corev1.PersistentVolumeList{Items: items}, nil
+}
+
+// GetStorageClass returns the StorageClass with the given name from the snapshot
+func (c *SnapshotClient) GetStorageClass(ctx context.Context, name string) (*storagev1.StorageClass, error) {
+	obj, err := c.get(storagev1.Resource("storageclasses"), "StorageClass", name, "")
+	if err != nil {
+		return nil, err
+	}
+	sc := &storagev1.StorageClass{}
+	return sc, runtime.DefaultUnstructuredConverter.FromUnstructured(obj.Object, sc)
+}
+
+// ListConfigMaps returns the ConfigMaps matching the label selector
+func (c *SnapshotClient) ListConfigMaps(ctx context.Context, namespace string, labelSelector string) (*corev1.ConfigMapList, error) {
+	items, err := listTyped[corev1.ConfigMap](c, "ConfigMap", namespace, labelSelector)
+	if err != nil {
+		return nil, err
+	}
+	return &corev1.ConfigMapList{Items: items}, nil
+}
+
+// ListSecrets returns the Secrets matching the label selector
+func (c *SnapshotClient) ListSecrets(ctx context.Context, namespace string, labelSelector string) (*corev1.SecretList, error) {
+	items, err := listTyped[corev1.Secret](c, "Secret", namespace, labelSelector)
+	if err != nil {
+		return nil, err
+	}
+	return &corev1.SecretList{Items: items}, nil
+}
+
+// GetDataLoads returns the DataLoads in a namespace that target the given Dataset
+func (c *SnapshotClient) GetDataLoads(ctx context.Context, datasetName, namespace string) (*unstructured.UnstructuredList, error) {
+	items, err := c.list("DataLoad", namespace, "")
+	if err != nil {
+		return nil, err
+	}
+	list := &unstructured.UnstructuredList{Items: items}
+	return filterByTargetDataset(list, datasetName, namespace, []string{"spec", "dataset"}), nil
+}
+
+// GetDataBackups returns the DataBackups in a namespace that back up the given Dataset
+func (c *SnapshotClient) GetDataBackups(ctx context.Context, datasetName, namespace string) (*unstructured.UnstructuredList, error) {
+	items, err := c.list("DataBackup", namespace, "")
+	if err != nil {
+		return nil, err
+	}
+	return filterDataBackups(&unstructured.UnstructuredList{Items: items}, datasetName), nil
+}
+
+// GetDataMigrates returns the DataMigrates in a namespace that migrate data from or to the given Dataset
+func (c *SnapshotClient) GetDataMigrates(ctx context.Context, datasetName, namespace string) (*unstructured.UnstructuredList, error) {
+	items, err := c.list("DataMigrate", namespace, "")
+	if err != nil {
+		return nil, err
+	}
+	list := &unstructured.UnstructuredList{Items: items}
+	return filterByTargetDataset(list, datasetName, namespace,
+		[]string{"spec", "from", "dataset"},
+		[]string{"spec", "to", "dataset"},
+	), nil
+}
+
+// ListEvents returns the Events in a namespace whose involved object has the given name
+func (c *SnapshotClient) ListEvents(ctx context.Context, namespace, involvedObjectName string) (*corev1.EventList, error) {
+	items, err := listTyped[corev1.Event](c, "Event", namespace, "")
+	if err != nil {
+		return nil, err
+	}
+
+	list := &corev1.EventList{}
+	for _, event := range items {
+		if event.InvolvedObject.Name == involvedObjectName {
+			list.Items = append(list.Items, event)
+		}
+	}
+	return list, nil
+}
+
+// ListHorizontalPodAutoscalers returns the HorizontalPodAutoscalers in the namespace
+func (c *SnapshotClient) ListHorizontalPodAutoscalers(ctx context.Context, namespace string) (*autoscalingv2.HorizontalPodAutoscalerList, error) {
+	items, err := listTyped[autoscalingv2.HorizontalPodAutoscaler](c, "HorizontalPodAutoscaler", namespace, "")
+	if err != nil {
+		return nil, err
+	}
+	return &autoscalingv2.HorizontalPodAutoscalerList{Items: items}, nil
+}
+
+// ListPodMetrics returns the PodMetrics captured in the snapshot, if any
+func (c *SnapshotClient) ListPodMetrics(ctx context.Context, namespace string, labelSelector string) (*unstructured.UnstructuredList, error) {
+	items, err := c.list("PodMetrics", namespace, labelSelector)
+	if err != nil {
+		return nil, err
+	}
+	return &unstructured.UnstructuredList{Items: items}, nil
+}
+
+// CountResources counts the snapshot objects of the kind matching the label selector
+func (c *SnapshotClient) CountResources(ctx context.Context, namespace, kind, labelSelector string) (int, error) {
+	items, err := c.list(kind, namespace, labelSelector)
+	return len(items), err
+}
+
+// GetObjectMeta gets a snapshot object of the kind by name
+func (c *SnapshotClient) GetObjectMeta(ctx context.Context, namespace, kind, name string) (metav1.Object, error) {
+	gvr, ok := namespacedKinds[kind]
+	if !ok {
+		return nil, fmt.Errorf("cannot get kind %s", kind)
+	}
+	return c.get(gvr.GroupResource(), kind, name, namespace)
+}
+
+// get returns the object of the given kind, name and namespace, or a
+// NotFound error like the API server would
+func (c *SnapshotClient) get(resource schema.GroupResource, kind, name, namespace string) (*unstructured.Unstructured, error) {
+	for i := range c.objects {
+		obj := &c.objects[i]
+		if obj.GetKind() == kind && obj.GetName() == name && obj.GetNamespace() == namespace {
+			return obj.DeepCopy(), nil
+		}
+	}
+	return nil, apierrors.NewNotFound(resource, name)
+}
+
+// list returns copies of the objects of the given kind in a namespace (all
+// namespaces if empty) matching the label selector
+func (c *SnapshotClient) list(kind, namespace, labelSelector string) ([]unstructured.Unstructured, error) {
+	selector, err := labels.Parse(labelSelector)
+	if err != nil {
+		return nil, fmt.Errorf("invalid label selector %q: %w", labelSelector, err)
+	}
+
+	var items []unstructured.Unstructured
+	for i := range c.objects {
+		obj := &c.objects[i]
+		if obj.GetKind() != kind {
+			continue
+		}
+		if namespace != "" && obj.GetNamespace() != namespace {
+			continue
+		}
+		if !selector.Matches(labels.Set(obj.GetLabels())) {
+			continue
+		}
+		items = append(items, *obj.DeepCopy())
+	}
+	return items, nil
+}
+
+// listTyped lists objects like list and converts them to typed items
+func listTyped[T any](c *SnapshotClient, kind, namespace, labelSelector string) ([]T, error) {
+	items, err := c.list(kind, namespace, labelSelector)
+	if err != nil {
+		return nil, err
+	}
+
+	typed := make([]T, len(items))
+	for i, item := range items {
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(item.Object, &typed[i]); err != nil {
+			return nil, fmt.Errorf("failed to decode %s %s/%s: %w", kind, item.GetNamespace(), item.GetName(), err)
+		}
+	}
+	return typed, nil
+}
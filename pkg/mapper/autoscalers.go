@@ -0,0 +1,100 @@
+// Package mapper autoscaler discovery
+package mapper
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/fluid-cloudnative/fluid-resource-mapper/pkg/k8s"
+	"github.com/fluid-cloudnative/fluid-resource-mapper/pkg/types"
+)
+
+// discoverAutoscalers discovers the HorizontalPodAutoscalers scaling a
+// release's workers. HPAs carry no Fluid labels, so every HPA in the
+// namespace is listed and matched by scale target: the worker StatefulSet,
+// or the Runtime itself through its scale subresource.
+func (m *Mapper) discoverAutoscalers(ctx context.Context, namespace, releaseName string) ([]types.K8sResourceNode, []types.MappingWarning) {
+	var resources []types.K8sResourceNode
+	var warnings []types.MappingWarning
+
+	hpaList, err := m.client.ListHorizontalPodAutoscalers(ctx, namespace)
+	if err != nil {
+		warnings = append(warnings, types.MappingWarning{
+			Level:   types.WarningLevelInfo,
+			Code:    "HPA_LIST_FAILED",
+			Message: fmt.Sprintf("Failed to list HorizontalPodAutoscalers: %v", err),
+		})
+		return resources, warnings
+	}
+
+	for _, hpa := range hpaList.Items {
+		if !scalesWorkers(hpa.Spec.ScaleTargetRef, releaseName) {
+			continue
+		}
+
+		minReplicas := int32(1)
+		if hpa.Spec.MinReplicas != nil {
+			minReplicas = *hpa.Spec.MinReplicas
+		}
+		current, desired := hpa.Status.CurrentReplicas, hpa.Status.DesiredReplicas
+
+		node := types.K8sResourceNode{
+			Kind:              ResourceKinds.HorizontalPodAutoscaler,
+			APIVersion:        "autoscaling/v2",
+			Name:              hpa.Name,
+			Namespace:         hpa.Namespace,
+			Component:         types.ComponentWorker,
+			DeletionTimestamp: deletionTimestamp(hpa.DeletionTimestamp),
+			Terminating:       hpa.DeletionTimestamp != nil,
+			Status: types.ResourceStatus{
+				Phase:     autoscalerPhase(hpa),
+				Ready:     fmt.Sprintf("%d/%d", current, desired),
+				Age:       formatAge(hpa.CreationTimestamp.Time),
+				CreatedAt: hpa.CreationTimestamp.Time,
+			},
+			Labels: filterLabels(hpa.Labels),
+			Details: map[string]string{
+				"target":          hpa.Spec.ScaleTargetRef.Kind + "/" + hpa.Spec.ScaleTargetRef.Name,
+				"currentReplicas": strconv.Itoa(int(current)),
+				"desiredReplicas": strconv.Itoa(int(desired)),
+				"minReplicas":     strconv.Itoa(int(minReplicas)),
+				"maxReplicas":     strconv.Itoa(int(hpa.Spec.MaxReplicas)),
+			},
+		}
+		node.Owner = ownerInfo(hpa.OwnerReferences)
+
+		resources = append(resources, node)
+	}
+
+	return resources, warnings
+}
+
+// scalesWorkers reports whether an HPA scale target is the release's worker
+// StatefulSet or its Runtime
+func scalesWorkers(target autoscalingv2.CrossVersionObjectReference, releaseName string) bool {
+	if target.Kind == "StatefulSet" {
+		return target.Name == NamingConventions.WorkerStatefulSet(releaseName)
+	}
+	return target.Name == releaseName &&
+		strings.HasSuffix(target.Kind, "Runtime") &&
+		strings.HasPrefix(target.APIVersion, k8s.FluidAPIGroup+"/")
+}
+
+// autoscalerPhase is NotReady when the HPA cannot compute or apply a scale,
+// Updating while it is moving the workers to a new replica count, and Ready otherwise
+func autoscalerPhase(hpa autoscalingv2.HorizontalPodAutoscaler) types.ResourcePhase {
+	for _, c := range hpa.Status.Conditions {
+		if (c.Type == autoscalingv2.AbleToScale || c.Type == autoscalingv2.ScalingActive) && c.Status == corev1.ConditionFalse {
+			return types.PhaseNotReady
+		}
+	}
+	if hpa.Status.CurrentReplicas != hpa.Status.DesiredReplicas {
+		return types.PhaseUpdating
+	}
+	return types.PhaseReady
+}
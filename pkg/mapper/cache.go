@@ -0,0 +1,145 @@
+// Package mapper per-run API response cache
+package mapper
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+
+	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	corev1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/fluid-cloudnative/fluid-resource-mapper/pkg/k8s"
+)
+
+// runCache is a read-through cache in front of a k8s.Client for the duration
+// of one MapFromDataset call. Identical list and get calls, keyed by kind,
+// namespace and selector, are served from memory; concurrent identical calls
+// share a single request. Methods not overridden here pass straight through.
+type runCache struct {
+	k8s.Client
+	logger *slog.Logger
+
+	mu      sync.Mutex
+	entries map[string]*cacheEntry
+}
+
+// cacheEntry holds one cached response, filled exactly once
+type cacheEntry struct {
+	once  sync.Once
+	value interface{}
+	err   error
+}
+
+func newRunCache(client k8s.Client, logger *slog.Logger) *runCache {
+	return &runCache{
+		Client:  client,
+		logger:  logger,
+		entries: make(map[string]*cacheEntry),
+	}
+}
+
+// cached returns the response stored under key, calling fetch on first use
+func cached[T any](c *runCache, key string, fetch func() (T, error)) (T, error) {
+	c.mu.Lock()
+	entry, hit := c.entries[key]
+	if !hit {
+		entry = &cacheEntry{}
+		c.entries[key] = entry
+	}
+	c.mu.Unlock()
+
+	if hit {
+		c.logger.Debug("api cache hit", "key", key)
+	}
+	entry.once.Do(func() {
+		entry.value, entry.err = fetch()
+	})
+	value, _ := entry.value.(T)
+	return value, entry.err
+}
+
+func cacheKey(kind, namespace, selector string) string {
+	return kind + "/" + namespace + "/" + selector
+}
+
+func (c *runCache) ListStatefulSets(ctx context.Context, namespace string, labelSelector string) (*appsv1.StatefulSetList, error) {
+	return cached(c, cacheKey("StatefulSet", namespace, labelSelector), func() (*appsv1.StatefulSetList, error) {
+		return c.Client.ListStatefulSets(ctx, namespace, labelSelector)
+	})
+}
+
+func (c *runCache) ListDaemonSets(ctx context.Context, namespace string, labelSelector string) (*appsv1.DaemonSetList, error) {
+	return cached(c, cacheKey("DaemonSet", namespace, labelSelector), func() (*appsv1.DaemonSetList, error) {
+		return c.Client.ListDaemonSets(ctx, namespace, labelSelector)
+	})
+}
+
+func (c *runCache) ListPods(ctx context.Context, namespace string, labelSelector, fieldSelector string) (*corev1.PodList, error) {
+	return cached(c, cacheKey("Pod", namespace, labelSelector+"|"+fieldSelector), func() (*corev1.PodList, error) {
+		return c.Client.ListPods(ctx, namespace, labelSelector, fieldSelector)
+	})
+}
+
+func (c *runCache) ListServices(ctx context.Context, namespace string, labelSelector string) (*corev1.ServiceList, error) {
+	return cached(c, cacheKey("Service", namespace, labelSelector), func() (*corev1.ServiceList, error) {
+		return c.Client.ListServices(ctx, namespace, labelSelector)
+	})
+}
+
+func (c *runCache) GetEndpoints(ctx context.Context, namespace, serviceName string) (*corev1.Endpoints, error) {
+	return cached(c, cacheKey("Endpoints", namespace, "name="+serviceName), func() (*corev1.Endpoints, error) {
+		return c.Client.GetEndpoints(ctx, namespace, serviceName)
+	})
+}
+
+func (c *runCache) ListPVCs(ctx context.Context, namespace string, labelSelector string) (*corev1.PersistentVolumeClaimList, error) {
+	return cached(c, cacheKey("PersistentVolumeClaim", namespace, labelSelector), func() (*corev1.PersistentVolumeClaimList, error) {
+		return c.Client.ListPVCs(ctx, namespace, labelSelector)
+	})
+}
+
+func (c *runCache) GetPV(ctx context.Context, name string) (*corev1.PersistentVolume, error) {
+	return cached(c, cacheKey("PersistentVolume", "", "name="+name), func() (*corev1.PersistentVolume, error) {
+		return c.Client.GetPV(ctx, name)
+	})
+}
+
+func (c *runCache) ListPVs(ctx context.Context, labelSelector string) (*corev1.PersistentVolumeList, error) {
+	return cached(c, cacheKey("PersistentVolume", "", labelSelector), func() (*corev1.PersistentVolumeList, error) {
+		return c.Client.ListPVs(ctx, labelSelector)
+	})
+}
+
+func (c *runCache) GetStorageClass(ctx context.Context, name string) (*storagev1.StorageClass, error) {
+	return cached(c, cacheKey("StorageClass", "", "name="+name), func() (*storagev1.StorageClass, error) {
+		return c.Client.GetStorageClass(ctx, name)
+	})
+}
+
+func (c *runCache) ListConfigMaps(ctx context.Context, namespace string, labelSelector string) (*corev1.ConfigMapList, error) {
+	return cached(c, cacheKey("ConfigMap", namespace, labelSelector), func() (*corev1.ConfigMapList, error) {
+		return c.Client.ListConfigMaps(ctx, namespace, labelSelector)
+	})
+}
+
+func (c *runCache) ListSecrets(ctx context.Context, namespace string, labelSelector string) (*corev1.SecretList, error) {
+	return cached(c, cacheKey("Secret", namespace, labelSelector), func() (*corev1.SecretList, error) {
+		return c.Client.ListSecrets(ctx, namespace, labelSelector)
+	})
+}
+
+func (c *runCache) ListHorizontalPodAutoscalers(ctx context.Context, namespace string) (*autoscalingv2.HorizontalPodAutoscalerList, error) {
+	return cached(c, cacheKey("HorizontalPodAutoscaler", namespace, ""), func() (*autoscalingv2.HorizontalPodAutoscalerList, error) {
+		return c.Client.ListHorizontalPodAutoscalers(ctx, namespace)
+	})
+}
+
+func (c *runCache) ListPodMetrics(ctx context.Context, namespace string, labelSelector string) (*unstructured.UnstructuredList, error) {
+	return cached(c, cacheKey("PodMetrics", namespace, labelSelector), func() (*unstructured.UnstructuredList, error) {
+		return c.Client.ListPodMetrics(ctx, namespace, labelSelector)
+	})
+}
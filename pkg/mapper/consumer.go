@@ -0,0 +1,66 @@
+// Package mapper PVC consumer discovery
+package mapper
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/fluid-cloudnative/fluid-resource-mapper/pkg/types"
+)
+
+// discoverConsumers finds the pods in the namespace that mount one of the
+// given PVCs through a persistentVolumeClaim volume, keyed by claim name.
+// These are application pods outside the runtime, so their own failures are
+// not reported as warnings against the Dataset.
+func (m *Mapper) discoverConsumers(ctx context.Context, namespace string, claims []corev1.PersistentVolumeClaim) (map[string][]types.K8sResourceNode, []types.MappingWarning) {
+	if len(claims) == 0 {
+		return nil, nil
+	}
+
+	podList, err := m.client.ListPods(ctx, namespace, "", "")
+	if err != nil {
+		return nil, []types.MappingWarning{{
+			Level:   types.WarningLevelInfo,
+			Code:    "CONSUMER_LIST_FAILED",
+			Message: fmt.Sprintf("Failed to list pods mounting the Dataset's PVCs: %v", err),
+		}}
+	}
+
+	// Index the mounting pods under the claim name, which stands in for an
+	// owner UID since a PVC does not own the pods using it
+	pods := &podIndex{byOwnerUID: make(map[string][]corev1.Pod)}
+	for _, pod := range podList.Items {
+		for _, claim := range mountedClaims(pod) {
+			pods.byOwnerUID[claim] = append(pods.byOwnerUID[claim], pod)
+		}
+	}
+
+	consumers := make(map[string][]types.K8sResourceNode)
+	for _, claim := range claims {
+		if len(pods.byOwnerUID[claim.Name]) == 0 {
+			continue
+		}
+		children, _ := m.discoverPodsForWorkload(ctx, pods, claim.Name, claim.Name)
+		for i := range children {
+			children[i].Component = types.ComponentConsumer
+		}
+		consumers[claim.Name] = children
+	}
+	return consumers, nil
+}
+
+// mountedClaims returns the distinct PVC names the pod mounts as volumes
+func mountedClaims(pod corev1.Pod) []string {
+	var claims []string
+	seen := make(map[string]bool)
+	for _, v := range pod.Spec.Volumes {
+		if v.PersistentVolumeClaim == nil || seen[v.PersistentVolumeClaim.ClaimName] {
+			continue
+		}
+		seen[v.PersistentVolumeClaim.ClaimName] = true
+		claims = append(claims, v.PersistentVolumeClaim.ClaimName)
+	}
+	return claims
+}
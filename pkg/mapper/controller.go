@@ -0,0 +1,184 @@
+// Package mapper runtime controller discovery
+package mapper
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/fluid-cloudnative/fluid-resource-mapper/pkg/types"
+)
+
+// DefaultControllerNamespace is the namespace Fluid's Helm chart installs its controllers into
+const DefaultControllerNamespace = "fluid-system"
+
+// discoverControllers looks up the controller Deployment of every runtime
+// type whose master or worker is missing, since a controller that is down is
+// the usual reason a runtime's workloads were never created. A missing fuse
+// alone is expected (it starts on demand) and does not trigger the lookup.
+func (m *Mapper) discoverControllers(ctx context.Context, graph *types.ResourceGraph, opts Options) ([]types.K8sResourceNode, []types.MappingWarning) {
+	var resources []types.K8sResourceNode
+	var warnings []types.MappingWarning
+
+	checked := make(map[types.RuntimeType]bool)
+	for _, runtime := range graph.Runtimes {
+		missing := missingWorkloads(graph.GetResourcesByRuntime(runtime.Name), runtime)
+		if len(missing) == 0 || checked[runtime.Type] {
+			continue
+		}
+		checked[runtime.Type] = true
+
+		nodes, controllerWarnings := m.discoverController(ctx, runtime, missing, opts.controllerNamespace())
+		resources = append(resources, nodes...)
+		warnings = append(warnings, controllerWarnings...)
+	}
+
+	return resources, warnings
+}
+
+// discoverController fetches a runtime type's controller Deployment and its
+// pods, and raises CONTROLLER_UNAVAILABLE unless it has every replica ready
+func (m *Mapper) discoverController(ctx context.Context, runtime *types.RuntimeNode, missing []string, namespace string) ([]types.K8sResourceNode, []types.MappingWarning) {
+	name := NamingConventions.RuntimeController(string(runtime.Type))
+	ref := namespace + "/" + name
+
+	deployment, err := m.client.GetDeployment(ctx, namespace, name)
+	if apierrors.IsNotFound(err) {
+		return nil, []types.MappingWarning{{
+			Level:      types.WarningLevelError,
+			Code:       types.WarningCodes.ControllerUnavailable,
+			Message:    fmt.Sprintf("Runtime %s is missing its %s, and the runtime controller %s does not exist", runtime.Name, joinComponents(missing), ref),
+			Resource:   name,
+			Suggestion: "Install Fluid with the " + string(runtime.Type) + " runtime enabled, or set the controller namespace to the one Fluid runs in",
+		}}
+	}
+	if err != nil {
+		return nil, []types.MappingWarning{{
+			Level:    types.WarningLevelInfo,
+			Code:     "CONTROLLER_CHECK_FAILED",
+			Message:  fmt.Sprintf("Failed to get runtime controller %s: %v", ref, err),
+			Resource: name,
+		}}
+	}
+
+	desired := int32(1)
+	if deployment.Spec.Replicas != nil {
+		desired = *deployment.Spec.Replicas
+	}
+	ready := deployment.Status.ReadyReplicas
+
+	node := types.K8sResourceNode{
+		Kind:              "Deployment",
+		APIVersion:        "apps/v1",
+		Name:              deployment.Name,
+		Namespace:         deployment.Namespace,
+		Component:         types.ComponentController,
+		DeletionTimestamp: deletionTimestamp(deployment.DeletionTimestamp),
+		Terminating:       deployment.DeletionTimestamp != nil,
+		Status: types.ResourceStatus{
+			Phase:     deploymentPhase(desired, ready),
+			Ready:     fmt.Sprintf("%d/%d", ready, desired),
+			Age:       formatAge(deployment.CreationTimestamp.Time),
+			CreatedAt: deployment.CreationTimestamp.Time,
+		},
+		Labels: filterLabels(deployment.Labels),
+		Details: map[string]string{
+			"runtimeType": string(runtime.Type),
+			"replicas":    strconv.Itoa(int(desired)),
+		},
+	}
+	if images := containerImages(deployment.Spec.Template.Spec); images != "" {
+		node.Details["image"] = images
+	}
+	node.Owner = ownerInfo(deployment.OwnerReferences)
+
+	children, warnings := m.discoverControllerPods(ctx, deployment)
+	node.Children = children
+
+	switch {
+	case desired == 0:
+		warnings = append(warnings, types.MappingWarning{
+			Level:      types.WarningLevelError,
+			Code:       types.WarningCodes.ControllerUnavailable,
+			Message:    fmt.Sprintf("Runtime %s is missing its %s, and the runtime controller %s is scaled to 0 replicas", runtime.Name, joinComponents(missing), ref),
+			Resource:   name,
+			Suggestion: fmt.Sprintf("Scale the controller up: kubectl -n %s scale deployment %s --replicas=1", namespace, name),
+		})
+	case ready < desired:
+		warnings = append(warnings, types.MappingWarning{
+			Level:      types.WarningLevelError,
+			Code:       types.WarningCodes.ControllerUnavailable,
+			Message:    fmt.Sprintf("Runtime %s is missing its %s, and the runtime controller %s has %d/%d replicas ready", runtime.Name, joinComponents(missing), ref, ready, desired),
+			Resource:   name,
+			Suggestion: fmt.Sprintf("Check the controller pods' events and logs: kubectl -n %s logs deployment/%s", namespace, name),
+		})
+	}
+
+	return []types.K8sResourceNode{node}, warnings
+}
+
+// discoverControllerPods lists the pods selected by the controller Deployment.
+// They are owned by its ReplicaSet rather than by the Deployment, so the whole
+// listing is indexed under the Deployment's UID.
+func (m *Mapper) discoverControllerPods(ctx context.Context, deployment *appsv1.Deployment) ([]types.K8sResourceNode, []types.MappingWarning) {
+	if deployment.Spec.Selector == nil {
+		return nil, nil
+	}
+	selector, err := metav1.LabelSelectorAsSelector(deployment.Spec.Selector)
+	if err != nil {
+		return nil, nil
+	}
+
+	podList, err := m.client.ListPods(ctx, deployment.Namespace, selector.String(), "")
+	if err != nil {
+		return nil, []types.MappingWarning{{
+			Level:    types.WarningLevelInfo,
+			Code:     "CONTROLLER_CHECK_FAILED",
+			Message:  fmt.Sprintf("Failed to list pods of runtime controller %s/%s: %v", deployment.Namespace, deployment.Name, err),
+			Resource: deployment.Name,
+		}}
+	}
+
+	pods := &podIndex{byOwnerUID: map[string][]corev1.Pod{string(deployment.UID): podList.Items}}
+	children, warnings := m.discoverPodsForWorkload(ctx, pods, string(deployment.UID), deployment.Name)
+	for i := range children {
+		children[i].Component = types.ComponentController
+	}
+	return children, warnings
+}
+
+// deploymentPhase is Ready when every desired replica is ready and NotReady
+// otherwise, including a Deployment scaled to zero
+func deploymentPhase(desired, ready int32) types.ResourcePhase {
+	if desired > 0 && ready >= desired {
+		return types.PhaseReady
+	}
+	return types.PhaseNotReady
+}
+
+// missingWorkloads names the master and worker components a runtime's type
+// deploys but that have no resources
+func missingWorkloads(resources []types.K8sResourceNode, runtime *types.RuntimeNode) []string {
+	expected := GetRuntimeComponents(runtime.Type)
+	var missing []string
+	if expected.HasMaster && len(filterByComponent(resources, types.ComponentMaster)) == 0 {
+		missing = append(missing, "master")
+	}
+	if expected.HasWorker && len(filterByComponent(resources, types.ComponentWorker)) == 0 {
+		missing = append(missing, "worker")
+	}
+	return missing
+}
+
+// joinComponents formats component names for a message, e.g. "master and worker"
+func joinComponents(components []string) string {
+	if len(components) == 2 {
+		return components[0] + " and " + components[1]
+	}
+	return components[0]
+}
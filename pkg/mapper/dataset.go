@@ -2,6 +2,9 @@
 package mapper
 
 import (
+	"sort"
+	"strings"
+
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 
 	"github.com/fluid-cloudnative/fluid-resource-mapper/pkg/types"
@@ -59,38 +62,121 @@ func parseDataset(obj *unstructured.Unstructured) (*types.DatasetNode, error) {
 				if mount, ok := m.(map[string]interface{}); ok {
 					if mp, ok := mount["mountPoint"].(string); ok {
 						node.MountPoints = append(node.MountPoints, mp)
+						node.Mounts = append(node.Mounts, parseMount(mount))
 					}
 				}
 			}
 		}
+		if modes, ok := spec["accessModes"].([]interface{}); ok {
+			for _, m := range modes {
+				if mode, ok := m.(string); ok {
+					node.AccessModes = append(node.AccessModes, mode)
+				}
+			}
+		}
+		node.SharedOptions = stringMap(spec["sharedOptions"])
 	}
 
+	node.Runtimes = getRuntimeRefsFromDataset(obj)
+	node.Operations = getOperationsFromDataset(obj)
+	node.Owner = ownerInfo(obj.GetOwnerReferences())
+	node.RawSpec = rawSpec(obj)
+
 	return node, nil
 }
 
-// getRuntimeTypeFromDataset extracts the runtime type from dataset status
-func getRuntimeTypeFromDataset(obj *unstructured.Unstructured) (string, string, string, error) {
-	status, _, _ := unstructured.NestedMap(obj.Object, "status")
-	if status == nil {
-		return "", "", "", nil
+// parseMount converts a spec.mounts entry to a MountPoint
+func parseMount(mount map[string]interface{}) types.MountPoint {
+	mp := types.MountPoint{
+		MountPoint: getStringField(mount, "mountPoint"),
+		Name:       getStringField(mount, "name"),
+		Path:       getStringField(mount, "path"),
 	}
+	mp.Options = stringMap(mount["options"])
+	return mp
+}
 
-	runtimes, ok := status["runtimes"].([]interface{})
-	if !ok || len(runtimes) == 0 {
-		return "", "", "", nil
+// stringMap converts an options map of the spec to map[string]string,
+// skipping non-string values; nil when v is not a map
+func stringMap(v interface{}) map[string]string {
+	options, ok := v.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+	values := make(map[string]string, len(options))
+	for k, v := range options {
+		if value, ok := v.(string); ok {
+			values[k] = value
+		}
 	}
+	return values
+}
 
-	// Get the first runtime
-	runtime, ok := runtimes[0].(map[string]interface{})
-	if !ok {
-		return "", "", "", nil
+// getRuntimeRefsFromDataset extracts the runtime references from dataset status
+func getRuntimeRefsFromDataset(obj *unstructured.Unstructured) []types.RuntimeRef {
+	runtimes, found, _ := unstructured.NestedSlice(obj.Object, "status", "runtimes")
+	if !found {
+		return nil
+	}
+
+	var refs []types.RuntimeRef
+	for _, r := range runtimes {
+		runtime, ok := r.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		refs = append(refs, types.RuntimeRef{
+			Name:      getStringField(runtime, "name"),
+			Namespace: getStringField(runtime, "namespace"),
+			Type:      types.RuntimeType(getStringField(runtime, "type")),
+		})
+	}
+	return refs
+}
+
+// getOperationsFromDataset extracts the data operations holding the Dataset.
+// Newer Fluid versions record them in status.operationRef, keyed by operation
+// type with comma-separated names; older ones use dataLoadRef and dataBackupRef.
+func getOperationsFromDataset(obj *unstructured.Unstructured) []types.DatasetOperation {
+	seen := make(map[types.DatasetOperation]bool)
+	var operations []types.DatasetOperation
+	add := func(opType, names string) {
+		for _, name := range strings.Split(names, ",") {
+			op := types.DatasetOperation{Type: opType, Name: strings.TrimSpace(name)}
+			if op.Name == "" || seen[op] {
+				continue
+			}
+			seen[op] = true
+			operations = append(operations, op)
+		}
 	}
 
-	runtimeType := getStringField(runtime, "type")
-	runtimeName := getStringField(runtime, "name")
-	runtimeNamespace := getStringField(runtime, "namespace")
+	refs, _, _ := unstructured.NestedStringMap(obj.Object, "status", "operationRef")
+	for opType, names := range refs {
+		add(opType, names)
+	}
+	if name, _, _ := unstructured.NestedString(obj.Object, "status", "dataLoadRef"); name != "" {
+		add(ResourceKinds.DataLoad, name)
+	}
+	if name, _, _ := unstructured.NestedString(obj.Object, "status", "dataBackupRef"); name != "" {
+		add(ResourceKinds.DataBackup, name)
+	}
+
+	sort.Slice(operations, func(i, j int) bool {
+		if operations[i].Type != operations[j].Type {
+			return operations[i].Type < operations[j].Type
+		}
+		return operations[i].Name < operations[j].Name
+	})
+	return operations
+}
 
-	return runtimeType, runtimeName, runtimeNamespace, nil
+// rawSpec returns the object's spec as-is. NestedMap is avoided because its
+// deep copy panics on the plain int values mock objects carry.
+func rawSpec(obj *unstructured.Unstructured) map[string]interface{} {
+	spec, _, _ := unstructured.NestedFieldNoCopy(obj.Object, "spec")
+	m, _ := spec.(map[string]interface{})
+	return m
 }
 
 // getStringField safely extracts a string field from a map
@@ -0,0 +1,59 @@
+// Package mapper typed errors
+package mapper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+)
+
+// Typed errors returned by the mapper. Callers should match them with errors.Is.
+var (
+	// ErrDatasetNotFound indicates the requested Dataset does not exist
+	ErrDatasetNotFound = errors.New("dataset not found")
+
+	// ErrClusterUnreachable indicates the Kubernetes API could not be reached:
+	// a transport failure, a timeout or an unavailable API server
+	ErrClusterUnreachable = errors.New("cluster unreachable")
+
+	// ErrAccessDenied indicates the API server rejected the request's
+	// credentials (Unauthorized) or RBAC does not allow it (Forbidden)
+	ErrAccessDenied = errors.New("access denied")
+
+	// ErrDatasetAmbiguous indicates a Dataset name searched across all
+	// namespaces exists in more than one of them
+	ErrDatasetAmbiguous = errors.New("dataset name is ambiguous")
+)
+
+// classifyDatasetError wraps an error from fetching a Dataset with the matching typed error
+func classifyDatasetError(err error) error {
+	if errors.Is(err, ErrDatasetNotFound) || errors.Is(err, ErrDatasetAmbiguous) {
+		return err
+	}
+	if apierrors.IsNotFound(err) {
+		return fmt.Errorf("%w: %v", ErrDatasetNotFound, err)
+	}
+	return classifyAPIError(err)
+}
+
+// classifyAPIError wraps an API error with ErrClusterUnreachable or
+// ErrAccessDenied. Any other error, e.g. an invalid request, is returned
+// unchanged, since retrying against the same cluster would fail the same way.
+func classifyAPIError(err error) error {
+	if errors.Is(err, ErrClusterUnreachable) || errors.Is(err, ErrAccessDenied) {
+		return err
+	}
+
+	var netErr net.Error
+	switch {
+	case apierrors.IsForbidden(err), apierrors.IsUnauthorized(err):
+		return fmt.Errorf("%w: %v", ErrAccessDenied, err)
+	case apierrors.IsServiceUnavailable(err), apierrors.IsTimeout(err), apierrors.IsServerTimeout(err),
+		errors.Is(err, context.DeadlineExceeded), errors.As(err, &netErr):
+		return fmt.Errorf("%w: %v", ErrClusterUnreachable, err)
+	}
+	return err
+}
@@ -0,0 +1,58 @@
+package mapper
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/url"
+	"syscall"
+	"testing"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+var datasetResource = schema.GroupResource{Group: "data.fluid.io", Resource: "datasets"}
+
+func TestClassifyDatasetError(t *testing.T) {
+	refused := &url.Error{
+		Op:  "Get",
+		URL: "https://10.0.0.1:6443/apis/data.fluid.io/v1alpha1/namespaces/default/datasets/demo-data",
+		Err: &net.OpError{Op: "dial", Net: "tcp", Err: syscall.ECONNREFUSED},
+	}
+	badRequest := apierrors.NewBadRequest("invalid field selector")
+
+	tests := []struct {
+		name string
+		err  error
+		want error // the sentinel the result must wrap, nil for none
+	}{
+		{"not found", apierrors.NewNotFound(datasetResource, "demo-data"), ErrDatasetNotFound},
+		{"already not found", ErrDatasetNotFound, ErrDatasetNotFound},
+		{"ambiguous", ErrDatasetAmbiguous, ErrDatasetAmbiguous},
+		{"forbidden", apierrors.NewForbidden(datasetResource, "demo-data", errors.New("RBAC: access denied")), ErrAccessDenied},
+		{"unauthorized", apierrors.NewUnauthorized("token expired"), ErrAccessDenied},
+		{"service unavailable", apierrors.NewServiceUnavailable("apiserver is shutting down"), ErrClusterUnreachable},
+		{"server timeout", apierrors.NewServerTimeout(datasetResource, "get", 5), ErrClusterUnreachable},
+		{"request timeout", apierrors.NewTimeoutError("request timed out", 5), ErrClusterUnreachable},
+		{"deadline exceeded", context.DeadlineExceeded, ErrClusterUnreachable},
+		{"connection refused", refused, ErrClusterUnreachable},
+		{"already unreachable", ErrClusterUnreachable, ErrClusterUnreachable},
+		{"bad request", badRequest, nil},
+	}
+
+	sentinels := []error{ErrDatasetNotFound, ErrDatasetAmbiguous, ErrClusterUnreachable, ErrAccessDenied}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := classifyDatasetError(tt.err)
+			for _, sentinel := range sentinels {
+				if is := errors.Is(got, sentinel); is != (sentinel == tt.want) {
+					t.Errorf("errors.Is(%v, %v) = %v, want %v", got, sentinel, is, !is)
+				}
+			}
+			if tt.want == nil && got != tt.err {
+				t.Errorf("classifyDatasetError(%v) = %v, want the error unchanged", tt.err, got)
+			}
+		})
+	}
+}
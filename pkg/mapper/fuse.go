@@ -0,0 +1,90 @@
+// Package mapper fuse mount mode detection
+package mapper
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/fluid-cloudnative/fluid-resource-mapper/pkg/types"
+)
+
+// sidecarInjectLabels are the application pod labels that ask Fluid's
+// webhook to inject the fuse as a sidecar instead of using the DaemonSet
+var sidecarInjectLabels = []string{
+	"serverless.fluid.io/inject",
+	"fuse.serverless.fluid.io/inject",
+}
+
+// fuseSidecarContainer prefixes the names of the fuse containers the webhook injects
+const fuseSidecarContainer = "fluid-fuse"
+
+// resolveFuseMode records how the runtime's fuse is deployed. A fuse
+// DaemonSet means DaemonSet mode; without one, application pods carrying an
+// injected fuse container for the runtime mean sidecar mode. The mode stays
+// empty when neither is found, e.g. before any application pod exists.
+func (m *Mapper) resolveFuseMode(ctx context.Context, runtime *types.RuntimeNode, resources []types.K8sResourceNode) {
+	for _, r := range resources {
+		if r.Component == types.ComponentFuse && r.Kind == "DaemonSet" {
+			runtime.FuseMode = types.FuseModeDaemonSet
+			return
+		}
+	}
+
+	if sidecars := m.fuseSidecars(ctx, runtime); len(sidecars) > 0 {
+		runtime.FuseMode = types.FuseModeSidecar
+		runtime.FuseSidecars = sidecars
+	}
+}
+
+// fuseSidecars returns the names of the application pods in the runtime's
+// namespace with an injected fuse container serving the runtime's Dataset.
+// Failures to list are ignored; the caller falls back to DaemonSet mode.
+func (m *Mapper) fuseSidecars(ctx context.Context, runtime *types.RuntimeNode) []string {
+	seen := make(map[string]bool)
+	var names []string
+	for _, label := range sidecarInjectLabels {
+		podList, err := m.client.ListPods(ctx, runtime.Namespace, label+"=true", "")
+		if err != nil {
+			m.logger.Debug("listing sidecar pods failed", "selector", label+"=true", "error", err)
+			continue
+		}
+		for _, pod := range podList.Items {
+			if seen[pod.Name] || !hasFuseSidecar(pod) || !servesDataset(pod, runtime.Name, runtime.Namespace) {
+				continue
+			}
+			seen[pod.Name] = true
+			names = append(names, pod.Name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+// hasFuseSidecar reports whether the pod runs an injected fuse container
+func hasFuseSidecar(pod corev1.Pod) bool {
+	for _, c := range append(pod.Spec.InitContainers, pod.Spec.Containers...) {
+		if strings.HasPrefix(c.Name, fuseSidecarContainer) {
+			return true
+		}
+	}
+	return false
+}
+
+// servesDataset reports whether the pod uses the Dataset, either through the
+// Dataset's PVC or through the fuse mount path Fluid rewrites that PVC to
+// (/runtime-mnt/<type>/<namespace>/<name>/...)
+func servesDataset(pod corev1.Pod, name, namespace string) bool {
+	mountPath := "/" + namespace + "/" + name + "/"
+	for _, v := range pod.Spec.Volumes {
+		if v.PersistentVolumeClaim != nil && v.PersistentVolumeClaim.ClaimName == name {
+			return true
+		}
+		if v.HostPath != nil && strings.Contains(v.HostPath.Path+"/", mountPath) {
+			return true
+		}
+	}
+	return false
+}
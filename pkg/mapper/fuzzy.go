@@ -0,0 +1,87 @@
+// Package mapper fuzzy dataset name matching
+package mapper
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// maxSuggestionDistance is the largest edit distance at which a Dataset name
+// is suggested for a mistyped one
+const maxSuggestionDistance = 2
+
+// suggestDatasets returns the names of Datasets close to name, nearest first.
+// Listing errors are ignored: suggestions are best effort.
+func (m *Mapper) suggestDatasets(ctx context.Context, name, namespace string) []string {
+	datasets, err := m.ListDatasets(ctx, namespace)
+	if err != nil {
+		return nil
+	}
+
+	candidates := make([]string, 0, len(datasets))
+	for _, dataset := range datasets {
+		candidates = append(candidates, dataset.Name)
+	}
+	return closestNames(name, candidates, maxSuggestionDistance)
+}
+
+// closestNames returns the distinct candidates within maxDistance edits of
+// name, ordered by distance and then alphabetically. An exact match is not a
+// suggestion and is skipped.
+func closestNames(name string, candidates []string, maxDistance int) []string {
+	distances := make(map[string]int)
+	for _, candidate := range candidates {
+		if candidate == name {
+			continue
+		}
+		if d := levenshtein(name, candidate); d <= maxDistance {
+			distances[candidate] = d
+		}
+	}
+
+	names := make([]string, 0, len(distances))
+	for candidate := range distances {
+		names = append(names, candidate)
+	}
+	sort.Slice(names, func(i, j int) bool {
+		if distances[names[i]] != distances[names[j]] {
+			return distances[names[i]] < distances[names[j]]
+		}
+		return names[i] < names[j]
+	})
+	return names
+}
+
+// levenshtein returns the edit distance between a and b
+func levenshtein(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	prev := make([]int, len(rb)+1)
+	curr := make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+
+	for i := 1; i <= len(ra); i++ {
+		curr[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+	return prev[len(rb)]
+}
+
+// didYouMean formats suggestions as "Did you mean 'a' or 'b'?"
+func didYouMean(names []string) string {
+	quoted := make([]string, len(names))
+	for i, name := range names {
+		quoted[i] = "'" + name + "'"
+	}
+	return fmt.Sprintf("Did you mean %s?", strings.Join(quoted, " or "))
+}
@@ -0,0 +1,100 @@
+package mapper
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestLevenshtein(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want int
+	}{
+		{"", "", 0},
+		{"", "demo", 4},
+		{"demo", "", 4},
+		{"demo-data", "demo-data", 0},
+		{"demo-data", "demo-date", 1},  // substitution
+		{"demo-data", "demo-dat", 1},   // deletion
+		{"demo-data", "demo-datas", 1}, // insertion
+		{"demo-data", "dmeo-data", 2},  // transposition counts as two edits
+		{"kitten", "sitting", 3},
+		{"données", "donnees", 1}, // runes, not bytes
+	}
+
+	for _, tt := range tests {
+		if got := levenshtein(tt.a, tt.b); got != tt.want {
+			t.Errorf("levenshtein(%q, %q) = %d, want %d", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
+
+func TestClosestNames(t *testing.T) {
+	tests := []struct {
+		name        string
+		target      string
+		candidates  []string
+		maxDistance int
+		want        []string
+	}{
+		{
+			name:        "no candidates",
+			target:      "demo-data",
+			candidates:  nil,
+			maxDistance: maxSuggestionDistance,
+			want:        []string{},
+		},
+		{
+			name:        "nearest first",
+			target:      "demo-data",
+			candidates:  []string{"demo-dt", "demo-dat", "other"},
+			maxDistance: maxSuggestionDistance,
+			want:        []string{"demo-dat", "demo-dt"},
+		},
+		{
+			name:        "ties ordered alphabetically",
+			target:      "demo-data",
+			candidates:  []string{"demo-datb", "demo-data1", "demo-datA"},
+			maxDistance: maxSuggestionDistance,
+			want:        []string{"demo-datA", "demo-data1", "demo-datb"},
+		},
+		{
+			name:        "distance at the threshold is kept",
+			target:      "demo-data",
+			candidates:  []string{"demo-dxtx"},
+			maxDistance: 2,
+			want:        []string{"demo-dxtx"},
+		},
+		{
+			name:        "distance beyond the threshold is dropped",
+			target:      "demo-data",
+			candidates:  []string{"demo-xxxx"},
+			maxDistance: 2,
+			want:        []string{},
+		},
+		{
+			name:        "exact match and duplicates skipped",
+			target:      "demo-data",
+			candidates:  []string{"demo-data", "demo-date", "demo-date"},
+			maxDistance: maxSuggestionDistance,
+			want:        []string{"demo-date"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := closestNames(tt.target, tt.candidates, tt.maxDistance); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("closestNames(%q, %v, %d) = %v, want %v", tt.target, tt.candidates, tt.maxDistance, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDidYouMean(t *testing.T) {
+	if got, want := didYouMean([]string{"demo-date"}), "Did you mean 'demo-date'?"; got != want {
+		t.Errorf("didYouMean = %q, want %q", got, want)
+	}
+	if got, want := didYouMean([]string{"demo-dat", "demo-date"}), "Did you mean 'demo-dat' or 'demo-date'?"; got != want {
+		t.Errorf("didYouMean = %q, want %q", got, want)
+	}
+}
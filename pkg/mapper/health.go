@@ -0,0 +1,103 @@
+// Package mapper count-based quick health check
+package mapper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/fluid-cloudnative/fluid-resource-mapper/pkg/types"
+)
+
+// quickHealthKinds are the kinds QuickHealth counts for each runtime
+var quickHealthKinds = []string{ResourceKinds.StatefulSet, ResourceKinds.DaemonSet, ResourceKinds.Pod}
+
+// QuickHealth decides whether a Dataset is healthy from its phase, its
+// Runtimes' reported component phases and the number of workloads and pods
+// they own, without fetching the resources themselves. It is meant for
+// liveness checks across many Datasets; use MapFromDataset to find out why
+// one is unhealthy. The error wraps ErrDatasetNotFound, ErrClusterUnreachable
+// or ErrAccessDenied when the Dataset cannot be fetched.
+func (m *Mapper) QuickHealth(ctx context.Context, name, namespace string) (*types.QuickHealth, error) {
+	dataset, err := m.resolveDataset(ctx, name, namespace)
+	if err != nil {
+		return nil, classifyDatasetError(err)
+	}
+
+	health := &types.QuickHealth{
+		Dataset:   dataset.Name,
+		Namespace: dataset.Namespace,
+		Phase:     dataset.Phase,
+		Counts:    make(map[string]int),
+	}
+	if dataset.Phase != "Bound" {
+		health.Reasons = append(health.Reasons, fmt.Sprintf("Dataset phase is %s, not Bound", dataset.Phase))
+	}
+
+	runtimes, _ := m.resolveRuntimes(ctx, *dataset)
+	if len(runtimes) == 0 && dataset.Phase == "Bound" {
+		health.Reasons = append(health.Reasons, "No Runtime could be resolved for the Dataset")
+	}
+
+	for _, runtime := range runtimes {
+		for _, c := range []struct{ name, phase, ready string }{
+			{"Master", runtime.MasterPhase, runtime.MasterReady},
+			{"Worker", runtime.WorkerPhase, runtime.WorkerReady},
+			{"Fuse", runtime.FusePhase, runtime.FuseReady},
+		} {
+			if c.phase == "PartialReady" || c.phase == string(types.PhaseFailed) {
+				health.Reasons = append(health.Reasons, fmt.Sprintf("Runtime %s reports %s phase %s (%s)", runtime.Name, c.name, c.phase, c.ready))
+			}
+		}
+
+		selector := m.countSelector(ctx, runtime.Name, runtime.Namespace)
+		counts := make(map[string]int, len(quickHealthKinds))
+		for _, kind := range quickHealthKinds {
+			count, err := m.client.CountResources(ctx, runtime.Namespace, kind, selector)
+			if err != nil {
+				return nil, fmt.Errorf("failed to count %ss of runtime %s: %w", kind, runtime.Name, err)
+			}
+			counts[kind] = count
+			health.Counts[kind] += count
+		}
+
+		expected := GetRuntimeComponents(runtime.Type)
+		want := 0
+		if expected.HasMaster {
+			want++
+		}
+		if expected.HasWorker {
+			want++
+		}
+		if got := counts[ResourceKinds.StatefulSet]; got < want {
+			health.Reasons = append(health.Reasons, fmt.Sprintf("Runtime %s has %d StatefulSets, expected %d (master and worker)", runtime.Name, got, want))
+		}
+		if expected.HasFuse && counts[ResourceKinds.DaemonSet] == 0 && len(m.fuseSidecars(ctx, runtime)) == 0 {
+			health.Reasons = append(health.Reasons, fmt.Sprintf("Runtime %s has no Fuse DaemonSet", runtime.Name))
+		}
+	}
+
+	health.Healthy = len(health.Reasons) == 0
+	return health, nil
+}
+
+// countSelector picks the label scheme of a release like resolveLabelSelector,
+// but by counting StatefulSets and DaemonSets rather than listing them
+func (m *Mapper) countSelector(ctx context.Context, name, namespace string) string {
+	if datasetSelector, err := NewSelectorBuilder().Dataset(namespace, name).Build(); err == nil {
+		var countErr error
+		for _, kind := range []string{ResourceKinds.StatefulSet, ResourceKinds.DaemonSet} {
+			count, err := m.client.CountResources(ctx, namespace, kind, datasetSelector)
+			if err == nil && count > 0 {
+				return datasetSelector
+			}
+			countErr = errors.Join(countErr, err)
+		}
+		if countErr != nil {
+			m.logger.Warn("cannot probe the dataset selector, keeping it without falling back to release", "selector", datasetSelector, "namespace", namespace, "error", countErr)
+			return datasetSelector
+		}
+	}
+	selector, _ := NewSelectorBuilder().Release(name).Build()
+	return selector
+}
@@ -5,9 +5,24 @@ package mapper
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"io"
+	"log/slog"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
 	"time"
 
+	"golang.org/x/sync/errgroup"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
 	"github.com/fluid-cloudnative/fluid-resource-mapper/pkg/k8s"
 	"github.com/fluid-cloudnative/fluid-resource-mapper/pkg/types"
 )
@@ -15,11 +30,19 @@ import (
 const (
 	// MapperVersion is the current version of the mapper
 	MapperVersion = "1.0.0"
+
+	// AllNamespaces searches every namespace when passed as the namespace
+	AllNamespaces = ""
+
+	// maxPodEvents is the number of recent Events attached to a non-Ready pod
+	maxPodEvents = 3
 )
 
 // Mapper is the main resource mapping engine
 type Mapper struct {
-	client k8s.Client
+	client   k8s.Client
+	logger   *slog.Logger
+	progress func(step string)
 }
 
 // Options configures the mapper behavior
@@ -27,33 +50,201 @@ type Options struct {
 	// IncludePods includes individual pods in the resource graph
 	IncludePods bool
 
+	// PodCounts replaces the pods attached to each workload with their count
+	// and how many are ready (Details podCount and podsReady), for compact
+	// output on large deployments. Pod-level warnings are still reported.
+	// It has no effect without IncludePods.
+	PodCounts bool
+
 	// IncludeConfigs includes ConfigMaps and Secrets
 	IncludeConfigs bool
 
 	// IncludeStorage includes PVCs and PVs
 	IncludeStorage bool
+
+	// ExtraLabelSelector further scopes workload, storage and config discovery,
+	// e.g. "shard=a" to map a single worker shard
+	ExtraLabelSelector string
+
+	// LabelKey is the label holding the release name on the runtime's
+	// resources, e.g. "app.kubernetes.io/instance" for Helm-managed
+	// deployments. Empty means fluid.io/dataset, falling back to release.
+	LabelKey string
+
+	// PodFieldSelector scopes pod discovery server-side, e.g.
+	// "status.phase!=Running" to map only the failing pods
+	PodFieldSelector string
+
+	// IncludeDataOperations includes data operation CRs (DataLoad, DataBackup,
+	// DataMigrate) targeting the Dataset
+	IncludeDataOperations bool
+
+	// IncludeKinds restricts discovery to the listed ResourceKinds values,
+	// e.g. ["StatefulSet","Pod"] for a workloads-only view. Empty means all kinds.
+	IncludeKinds []string
+
+	// ExcludeKinds skips discovery of the listed ResourceKinds values
+	ExcludeKinds []string
+
+	// IncludeUsage annotates pods with live CPU/memory usage from
+	// metrics.k8s.io; requires metrics-server in the cluster
+	IncludeUsage bool
+
+	// ResolveOwnerChain follows owner references beyond the direct owner
+	// (Owner.Parent), e.g. Pod → StatefulSet → AlluxioRuntime → Dataset
+	ResolveOwnerChain bool
+
+	// ConfigDenylist skips ConfigMaps and Secrets whose name (or Secret type)
+	// matches one of these path.Match patterns. Nil means
+	// DefaultConfigDenylist; an empty slice keeps everything.
+	ConfigDenylist []string
+
+	// CheckUFS reports Dataset conditions whose reason points at a failing
+	// UFS mount (reason containing "Mount" or "UFS") as UFS_UNREACHABLE
+	CheckUFS bool
+
+	// IncludeStorageClasses follows the storage chain PVC → PV → StorageClass,
+	// adding each StorageClass with its provisioner and parameters
+	IncludeStorageClasses bool
+
+	// IncludeConsumers attaches the pods mounting each of the Dataset's PVCs
+	// to the PVC as children, answering who still uses the Dataset
+	IncludeConsumers bool
+
+	// IncludeAutoscalers includes the HorizontalPodAutoscalers scaling a
+	// runtime's workers, either through the worker StatefulSet or the Runtime
+	IncludeAutoscalers bool
+
+	// IncludeRawSpec attaches the unparsed spec of the Dataset and Runtimes
+	// (RawSpec), for fields the parsed view does not cover
+	IncludeRawSpec bool
+
+	// CheckController looks up the runtime controller Deployment (e.g.
+	// alluxioruntime-controller) when a runtime's master or worker is
+	// missing, and adds it with its pods to the graph
+	CheckController bool
+
+	// ControllerNamespace is the namespace Fluid's controllers run in.
+	// Empty means DefaultControllerNamespace.
+	ControllerNamespace string
+}
+
+// kindEnabled reports whether resources of the given kind should be discovered
+func (o Options) kindEnabled(kind string) bool {
+	if len(o.IncludeKinds) > 0 && !containsKind(o.IncludeKinds, kind) {
+		return false
+	}
+	return !containsKind(o.ExcludeKinds, kind)
+}
+
+// configDenylist returns the ConfigDenylist, or the default if unset
+func (o Options) configDenylist() []string {
+	if o.ConfigDenylist == nil {
+		return DefaultConfigDenylist
+	}
+	return o.ConfigDenylist
+}
+
+// controllerNamespace returns the ControllerNamespace, or the default if unset
+func (o Options) controllerNamespace() string {
+	if o.ControllerNamespace == "" {
+		return DefaultControllerNamespace
+	}
+	return o.ControllerNamespace
+}
+
+// scoped reports whether the options narrow discovery to a subset of the
+// runtime's resources, in which case absent components are not reported missing
+func (o Options) scoped() bool {
+	return o.ExtraLabelSelector != "" || len(o.IncludeKinds) > 0 || len(o.ExcludeKinds) > 0
+}
+
+// validateKinds returns an error naming the first unknown kind in kinds
+func validateKinds(kinds []string) error {
+	for _, kind := range kinds {
+		if !containsKind(allResourceKinds(), kind) {
+			return fmt.Errorf("unknown resource kind %q, expected one of: %s",
+				kind, strings.Join(allResourceKinds(), ", "))
+		}
+	}
+	return nil
+}
+
+func containsKind(kinds []string, kind string) bool {
+	for _, k := range kinds {
+		if strings.EqualFold(k, kind) {
+			return true
+		}
+	}
+	return false
 }
 
 // DefaultOptions returns sensible default options
 func DefaultOptions() Options {
 	return Options{
-		IncludePods:    true,
-		IncludeConfigs: true,
-		IncludeStorage: true,
+		IncludePods:           true,
+		IncludeConfigs:        true,
+		IncludeStorage:        true,
+		IncludeDataOperations: true,
+		CheckUFS:              true,
 	}
 }
 
 // New creates a new Mapper with the given Kubernetes client
 func New(client k8s.Client) *Mapper {
 	return &Mapper{
-		client: client,
+		client:   client,
+		logger:   slog.New(slog.NewTextHandler(io.Discard, nil)),
+		progress: func(string) {},
+	}
+}
+
+// WithLogger sets the logger for discovery steps, selectors, counts and
+// timings. Steps are logged at Info, per-kind results at Debug.
+func (m *Mapper) WithLogger(logger *slog.Logger) *Mapper {
+	if logger != nil {
+		m.logger = logger
 	}
+	return m
 }
 
-// MapFromDataset maps all resources starting from a Dataset CR
+// WithProgress sets a callback told about each discovery step as it starts,
+// e.g. "Listing StatefulSets", for progress indicators. Discovery runs
+// concurrently, so the callback must be safe for concurrent use.
+func (m *Mapper) WithProgress(progress func(step string)) *Mapper {
+	if progress != nil {
+		m.progress = progress
+	}
+	return m
+}
+
+// MapFromDataset maps all resources starting from a Dataset CR.
+// With AllNamespaces the Dataset is looked up by name in every namespace.
+// If the Dataset cannot be fetched, the partial graph is returned together with
+// ErrDatasetNotFound, ErrDatasetAmbiguous, ErrClusterUnreachable or ErrAccessDenied.
 func (m *Mapper) MapFromDataset(ctx context.Context, name, namespace string, opts Options) (*types.ResourceGraph, error) {
 	startTime := time.Now()
 
+	if _, err := NewSelectorBuilder().Extra(opts.ExtraLabelSelector).Build(); err != nil {
+		return nil, err
+	}
+	if opts.LabelKey != "" {
+		if _, err := NewSelectorBuilder().Equals(opts.LabelKey, "").Build(); err != nil {
+			return nil, fmt.Errorf("invalid label key: %w", err)
+		}
+	}
+	if err := validateKinds(append(opts.IncludeKinds, opts.ExcludeKinds...)); err != nil {
+		return nil, err
+	}
+	if err := k8s.ValidatePodFieldSelector(opts.PodFieldSelector); err != nil {
+		return nil, err
+	}
+
+	// Serve repeated identical API calls within this run from memory
+	m = &Mapper{client: newRunCache(m.client, m.logger), logger: m.logger, progress: m.progress}
+
+	m.logger.Info("mapping dataset", "name", name, "namespace", namespace)
+
 	graph := &types.ResourceGraph{
 		Metadata: types.GraphMetadata{
 			MappedAt:    startTime,
@@ -63,49 +254,157 @@ func (m *Mapper) MapFromDataset(ctx context.Context, name, namespace string, opt
 	}
 
 	// Step 1: Fetch the Dataset
+	m.progress("Fetching Dataset")
 	dataset, err := m.resolveDataset(ctx, name, namespace)
 	if err != nil {
-		graph.Warnings = append(graph.Warnings, types.MappingWarning{
+		ref := namespace + "/" + name
+		if namespace == AllNamespaces {
+			ref = name
+		}
+		warning := types.MappingWarning{
 			Level:      types.WarningLevelError,
 			Code:       types.WarningCodes.DatasetNotFound,
-			Message:    fmt.Sprintf("Failed to get Dataset %s/%s: %v", namespace, name, err),
+			Message:    fmt.Sprintf("Failed to get Dataset %s: %v", ref, err),
 			Resource:   name,
 			Suggestion: "Verify the Dataset name and namespace are correct",
-		})
+		}
+		err = classifyDatasetError(err)
+		if errors.Is(err, ErrDatasetNotFound) {
+			if suggestions := m.suggestDatasets(ctx, name, namespace); len(suggestions) > 0 {
+				warning.Message = fmt.Sprintf("Dataset '%s' not found. %s", name, didYouMean(suggestions))
+			}
+		}
+		graph.Warnings = append(graph.Warnings, warning)
 		graph.Metadata.Duration = time.Since(startTime).String()
-		return graph, nil
+		return graph, err
 	}
 	graph.Dataset = *dataset
+	namespace = dataset.Namespace
 
-	// Step 2: Resolve the Runtime
-	runtime, err := m.resolveRuntime(ctx, *dataset)
-	if err != nil {
-		graph.Warnings = append(graph.Warnings, types.MappingWarning{
-			Level:      types.WarningLevelWarning,
-			Code:       types.WarningCodes.RuntimeNotBound,
-			Message:    fmt.Sprintf("No Runtime bound to Dataset: %v", err),
-			Resource:   name,
-			Suggestion: "Create a Runtime CR with the same name as the Dataset",
-		})
+	m.logger.Info("resolved dataset", "name", dataset.Name, "namespace", dataset.Namespace, "phase", dataset.Phase)
+
+	// Step 2: Resolve the Runtimes
+	m.progress("Resolving runtimes")
+	runtimes, runtimeWarnings := m.resolveRuntimes(ctx, *dataset)
+	for _, runtime := range runtimes {
+		m.logger.Info("resolved runtime", "name", runtime.Name, "namespace", runtime.Namespace, "type", runtime.Type)
+	}
+	graph.Warnings = append(graph.Warnings, runtimeWarnings...)
+	for _, runtime := range runtimes {
+		if warning, missing := m.resolveThinProfile(ctx, runtime); missing {
+			graph.Warnings = append(graph.Warnings, warning)
+		}
+	}
+	if len(runtimes) > 0 {
+		graph.Runtime = runtimes[0]
+		graph.Runtimes = runtimes
+	}
+
+	// Step 3: Discover Kubernetes resources for each runtime. Runtimes sharing
+	// a release are discovered once; without a runtime the dataset name is used.
+	targets := runtimes
+	if len(targets) == 0 {
+		targets = []*types.RuntimeNode{nil}
+	}
+	discovered := make(map[string]bool)
+	for _, runtime := range targets {
+		if ctx.Err() != nil {
+			break
+		}
+		releaseName, releaseNamespace := name, namespace
+		if runtime != nil {
+			releaseName, releaseNamespace = runtime.Name, runtime.Namespace
+		}
+		if discovered[releaseNamespace+"/"+releaseName] {
+			continue
+		}
+		discovered[releaseNamespace+"/"+releaseName] = true
+
+		labelSelector, err := m.resolveLabelSelector(ctx, releaseName, releaseNamespace, opts)
+		if err != nil {
+			graph.Warnings = append(graph.Warnings, types.MappingWarning{
+				Level:      types.WarningLevelError,
+				Code:       types.WarningCodes.InvalidLabelSelector,
+				Message:    fmt.Sprintf("Cannot build label selector for %s/%s: %v", releaseNamespace, releaseName, err),
+				Resource:   releaseName,
+				Suggestion: "Check that the runtime name is a valid label value",
+			})
+			continue
+		}
+		m.logger.Info("discovering resources", "release", releaseName, "namespace", releaseNamespace, "selector", labelSelector)
+		if graph.Metadata.LabelSelector == "" {
+			graph.Metadata.LabelSelector = labelSelector
+		}
+		resources, warnings := m.discoverResources(ctx, releaseNamespace, releaseName, labelSelector, runtime, opts)
+		graph.Resources = append(graph.Resources, resources...)
+		graph.Warnings = append(graph.Warnings, warnings...)
+
+		// A scoped discovery may have skipped the fuse DaemonSet, so its absence proves nothing
+		if runtime != nil && GetRuntimeComponents(runtime.Type).HasFuse && !opts.scoped() {
+			m.resolveFuseMode(ctx, runtime, resources)
+		}
+	}
+
+	// Data operations target the Dataset rather than a runtime release
+	if opts.IncludeDataOperations && ctx.Err() == nil {
+		m.progress("Listing data operations")
+		resources, warnings := m.discoverDataOperations(ctx, graph.Dataset, opts)
+		graph.Resources = append(graph.Resources, resources...)
+		graph.Warnings = append(graph.Warnings, warnings...)
+	}
+
+	// Missing workloads usually mean the runtime controller is down; a scoped
+	// discovery may have skipped them, so their absence proves nothing
+	if opts.CheckController && !opts.scoped() && ctx.Err() == nil {
+		m.progress("Checking runtime controllers")
+		resources, warnings := m.discoverControllers(ctx, graph, opts)
+		graph.Resources = append(graph.Resources, resources...)
+		graph.Warnings = append(graph.Warnings, warnings...)
+	}
+
+	if opts.ResolveOwnerChain {
+		resolveOwnerChains(graph)
+	}
+
+	// Discovery order depends on API return order and goroutine scheduling;
+	// sort so that output, and the warnings derived from it, are stable
+	sortResources(graph.Resources)
+
+	// Step 4: Detect additional warnings. A cancelled or timed-out context leaves
+	// the graph partial, so skip the missing-component checks that would misfire.
+	if warning, stop := interrupted(ctx, "completing discovery"); stop {
+		if !hasWarning(graph.Warnings, types.WarningCodes.MappingIncomplete) {
+			graph.Warnings = append(graph.Warnings, warning)
+		}
 	} else {
-		graph.Runtime = runtime
+		m.progress("Checking for problems")
+		graph.Warnings = append(graph.Warnings, m.detectWarnings(graph, opts)...)
 	}
 
-	// Step 3: Discover Kubernetes resources
-	resources, warnings := m.discoverResources(ctx, name, namespace, runtime, opts)
-	graph.Resources = resources
-	graph.Warnings = append(graph.Warnings, warnings...)
+	if !opts.IncludeRawSpec {
+		stripRawSpecs(graph)
+	}
+
+	// Pods are collapsed only now, so the checks above still saw each of them
+	if opts.PodCounts && opts.IncludePods && opts.kindEnabled(ResourceKinds.Pod) {
+		collapsePods(graph.Resources)
+	}
 
-	// Step 4: Detect additional warnings
-	graph.Warnings = append(graph.Warnings, m.detectWarnings(graph, runtime)...)
+	// Several checks can raise the same warning; report it once, most severe first
+	graph.Warnings = normalizeWarnings(graph.Warnings)
 
 	graph.Metadata.Duration = time.Since(startTime).String()
+	m.logger.Info("mapping complete", "resources", len(graph.Resources), "warnings", len(graph.Warnings), "duration", graph.Metadata.Duration)
 
 	return graph, nil
 }
 
 // resolveDataset fetches and parses a Dataset CR
 func (m *Mapper) resolveDataset(ctx context.Context, name, namespace string) (*types.DatasetNode, error) {
+	if namespace == AllNamespaces {
+		return m.findDataset(ctx, name)
+	}
+
 	obj, err := m.client.GetDataset(ctx, name, namespace)
 	if err != nil {
 		return nil, err
@@ -114,61 +413,441 @@ func (m *Mapper) resolveDataset(ctx context.Context, name, namespace string) (*t
 	return parseDataset(obj)
 }
 
-// resolveRuntime resolves the Runtime CR from the Dataset
-func (m *Mapper) resolveRuntime(ctx context.Context, dataset types.DatasetNode) (*types.RuntimeNode, error) {
+// findDataset looks up a Dataset by name across all namespaces
+func (m *Mapper) findDataset(ctx context.Context, name string) (*types.DatasetNode, error) {
+	datasets, err := m.ListDatasets(ctx, AllNamespaces)
+	if err != nil {
+		return nil, err
+	}
+
+	var found []types.DatasetNode
+	var namespaces []string
+	for _, dataset := range datasets {
+		if dataset.Name == name {
+			found = append(found, dataset)
+			namespaces = append(namespaces, dataset.Namespace)
+		}
+	}
+
+	switch len(found) {
+	case 0:
+		return nil, fmt.Errorf("%w: %s does not exist in any namespace", ErrDatasetNotFound, name)
+	case 1:
+		return &found[0], nil
+	default:
+		return nil, fmt.Errorf("%w: %s exists in namespaces %s", ErrDatasetAmbiguous, name, strings.Join(namespaces, ", "))
+	}
+}
+
+// ListDatasets returns the Datasets in a namespace, or in every namespace with AllNamespaces
+func (m *Mapper) ListDatasets(ctx context.Context, namespace string) ([]types.DatasetNode, error) {
+	list, err := m.client.ListDatasets(ctx, namespace)
+	if err != nil {
+		return nil, classifyAPIError(err)
+	}
+
+	datasets := make([]types.DatasetNode, 0, len(list.Items))
+	for i := range list.Items {
+		dataset, err := parseDataset(&list.Items[i])
+		if err != nil {
+			return nil, err
+		}
+		dataset.RawSpec = nil
+		datasets = append(datasets, *dataset)
+	}
+	return datasets, nil
+}
+
+// stripRawSpecs drops the unparsed specs the parsers always capture
+func stripRawSpecs(graph *types.ResourceGraph) {
+	graph.Dataset.RawSpec = nil
+	// graph.Runtime is the first entry of graph.Runtimes
+	for _, runtime := range graph.Runtimes {
+		runtime.RawSpec = nil
+	}
+}
+
+// collapsePods replaces each workload's child pods with Details podCount and podsReady
+func collapsePods(resources []types.K8sResourceNode) {
+	for i := range resources {
+		r := &resources[i]
+		if r.Kind != "StatefulSet" && r.Kind != "DaemonSet" && r.Kind != "Deployment" {
+			continue
+		}
+		ready := 0
+		for _, pod := range r.Children {
+			if pod.Status.Phase == types.PhaseReady {
+				ready++
+			}
+		}
+		if r.Details == nil {
+			r.Details = make(map[string]string)
+		}
+		r.Details["podCount"] = strconv.Itoa(len(r.Children))
+		r.Details["podsReady"] = strconv.Itoa(ready)
+		r.Children = nil
+	}
+}
+
+// resolveRuntimes resolves every Runtime CR bound to the Dataset
+func (m *Mapper) resolveRuntimes(ctx context.Context, dataset types.DatasetNode) ([]*types.RuntimeNode, []types.MappingWarning) {
 	// Check if dataset is bound
 	if dataset.Phase != "Bound" {
-		return nil, fmt.Errorf("dataset is not bound (phase: %s)", dataset.Phase)
+		return nil, []types.MappingWarning{{
+			Level:      types.WarningLevelWarning,
+			Code:       types.WarningCodes.RuntimeNotBound,
+			Message:    fmt.Sprintf("No Runtime bound to Dataset: dataset is not bound (phase: %s)", dataset.Phase),
+			Resource:   dataset.Name,
+			Suggestion: "Create a Runtime CR with the same name as the Dataset",
+		}}
+	}
+
+	refs := dataset.Runtimes
+	if len(refs) == 0 {
+		// Older Fluid versions do not populate status.runtimes; find the
+		// runtime by probing every known type for one named after the Dataset
+		if runtime, ok := m.probeRuntime(ctx, dataset); ok {
+			return []*types.RuntimeNode{runtime}, []types.MappingWarning{{
+				Level:      types.WarningLevelInfo,
+				Code:       types.WarningCodes.RuntimeTypeProbed,
+				Message:    fmt.Sprintf("Dataset status lists no runtimes; runtime type %s was inferred by probing", runtime.Type),
+				Resource:   dataset.Name,
+				Suggestion: "Upgrade Fluid so the Dataset status records its bound runtimes",
+			}}
+		}
+		// Default to alluxio so the failure is reported as RUNTIME_NOT_FOUND
+		refs = []types.RuntimeRef{{Name: dataset.Name, Namespace: dataset.Namespace, Type: types.RuntimeTypeAlluxio}}
+	}
+
+	var runtimes []*types.RuntimeNode
+	var warnings []types.MappingWarning
+	for _, ref := range refs {
+		if ctx.Err() != nil {
+			break
+		}
+		runtime, err := m.resolveRuntime(ctx, dataset, ref)
+		if err != nil {
+			warnings = append(warnings, types.MappingWarning{
+				Level:      types.WarningLevelWarning,
+				Code:       types.WarningCodes.RuntimeNotFound,
+				Message:    fmt.Sprintf("Failed to get %s Runtime for Dataset: %v", ref.Type, err),
+				Resource:   dataset.Name,
+				Suggestion: "Create a Runtime CR with the same name as the Dataset",
+			})
+			continue
+		}
+		if runtime.Name != dataset.Name || runtime.Namespace != dataset.Namespace {
+			warnings = append(warnings, types.MappingWarning{
+				Level:    types.WarningLevelInfo,
+				Code:     types.WarningCodes.RuntimeRefMismatch,
+				Message:  fmt.Sprintf("Dataset %s/%s is bound to %s Runtime %s/%s", dataset.Namespace, dataset.Name, runtime.Type, runtime.Namespace, runtime.Name),
+				Resource: runtime.Name,
+			})
+		}
+		runtimes = append(runtimes, runtime)
 	}
 
-	// For now, use the dataset name to find the runtime
-	// In a real implementation, we'd check .status.runtimes
-	runtimeType := "alluxio" // Default to alluxio, in reality parse from status.runtimes
+	return runtimes, warnings
+}
 
-	obj, err := m.client.GetRuntime(ctx, runtimeType, dataset.Name, dataset.Namespace)
+// resolveRuntime fetches and parses a single Runtime CR referenced by the
+// Dataset. The reference may name a runtime in another namespace or with
+// another name; fields it leaves empty default to the Dataset's.
+func (m *Mapper) resolveRuntime(ctx context.Context, dataset types.DatasetNode, ref types.RuntimeRef) (*types.RuntimeNode, error) {
+	name, namespace := ref.Name, ref.Namespace
+	if name == "" {
+		name = dataset.Name
+	}
+	if namespace == "" {
+		namespace = dataset.Namespace
+	}
+	obj, err := m.client.GetRuntime(ctx, string(ref.Type), name, namespace)
 	if err != nil {
 		return nil, err
 	}
 
-	return parseRuntime(obj, types.RuntimeType(runtimeType))
+	return parseRuntime(obj, ref.Type)
 }
 
-// discoverResources discovers all K8s resources related to the dataset
-func (m *Mapper) discoverResources(ctx context.Context, name, namespace string, runtime *types.RuntimeNode, opts Options) ([]types.K8sResourceNode, []types.MappingWarning) {
-	var resources []types.K8sResourceNode
-	var warnings []types.MappingWarning
+// resolveThinProfile fills in the ThinRuntimeProfile referenced by a
+// ThinRuntime, whose fuse and filesystem are defined there rather than in
+// the runtime. It returns a warning when the profile does not exist.
+func (m *Mapper) resolveThinProfile(ctx context.Context, runtime *types.RuntimeNode) (types.MappingWarning, bool) {
+	if runtime.Profile == nil {
+		return types.MappingWarning{}, false
+	}
+
+	obj, err := m.client.GetThinRuntimeProfile(ctx, runtime.Profile.Name)
+	if err != nil {
+		if !apierrors.IsNotFound(err) {
+			m.logger.Debug("thin runtime profile lookup failed", "profile", runtime.Profile.Name, "error", err)
+			return types.MappingWarning{}, false
+		}
+		return types.MappingWarning{
+			Level:      types.WarningLevelError,
+			Code:       types.WarningCodes.ThinProfileMissing,
+			Message:    fmt.Sprintf("ThinRuntime %s references ThinRuntimeProfile %s, which does not exist", runtime.Name, runtime.Profile.Name),
+			Resource:   runtime.Name,
+			Suggestion: "Create the ThinRuntimeProfile or fix the ThinRuntime's spec.profileName",
+		}, true
+	}
+
+	runtime.Profile.Found = true
+	runtime.Profile.FileSystemType, _, _ = unstructured.NestedString(obj.Object, "spec", "fileSystemType")
+	if runtime.Profile.FuseImage == "" {
+		runtime.Profile.FuseImage = fuseImage(obj)
+	}
+	return types.MappingWarning{}, false
+}
+
+// probeRuntime looks up a runtime named after the Dataset under every type in
+// k8s.RuntimeTypeToGVR, in alphabetical order, and returns the first one found.
+// Lookup errors, including CRDs that are not installed, count as misses.
+func (m *Mapper) probeRuntime(ctx context.Context, dataset types.DatasetNode) (*types.RuntimeNode, bool) {
+	runtimeTypes := make([]string, 0, len(k8s.RuntimeTypeToGVR))
+	for runtimeType := range k8s.RuntimeTypeToGVR {
+		runtimeTypes = append(runtimeTypes, runtimeType)
+	}
+	sort.Strings(runtimeTypes)
+
+	for _, runtimeType := range runtimeTypes {
+		if ctx.Err() != nil {
+			return nil, false
+		}
+		obj, err := m.client.GetRuntime(ctx, runtimeType, dataset.Name, dataset.Namespace)
+		if err != nil {
+			m.logger.Debug("runtime probe missed", "type", runtimeType, "error", err)
+			continue
+		}
+		runtime, err := parseRuntime(obj, types.RuntimeType(runtimeType))
+		if err != nil {
+			continue
+		}
+		m.logger.Info("runtime type inferred by probing", "type", runtimeType, "runtime", runtime.Name)
+		return runtime, true
+	}
+	return nil, false
+}
+
+// resolveLabelSelector picks the label scheme used by the dataset's runtime resources.
+// Newer Fluid releases label resources with fluid.io/dataset=<namespace>-<name>;
+// older ones use release=<name>, which is the fallback when the former matches no
+// StatefulSet or DaemonSet. A failed list proves nothing about the scheme, so
+// the fluid.io/dataset scheme is kept and the failure logged.
+// An explicit Options.LabelKey replaces both schemes. The extra selector is
+// appended to whichever scheme is picked.
+func (m *Mapper) resolveLabelSelector(ctx context.Context, name, namespace string, opts Options) (string, error) {
+	extra := opts.ExtraLabelSelector
+	if opts.LabelKey != "" {
+		return NewSelectorBuilder().Equals(opts.LabelKey, name).Extra(extra).Build()
+	}
+
+	if datasetSelector, err := NewSelectorBuilder().Dataset(namespace, name).Build(); err == nil {
+		stsList, stsErr := m.client.ListStatefulSets(ctx, namespace, datasetSelector)
+		if stsErr == nil && len(stsList.Items) > 0 {
+			return NewSelectorBuilder().Dataset(namespace, name).Extra(extra).Build()
+		}
+		dsList, dsErr := m.client.ListDaemonSets(ctx, namespace, datasetSelector)
+		if dsErr == nil && len(dsList.Items) > 0 {
+			return NewSelectorBuilder().Dataset(namespace, name).Extra(extra).Build()
+		}
+		if err := errors.Join(stsErr, dsErr); err != nil {
+			m.logger.Warn("cannot probe the dataset selector, keeping it without falling back to release", "selector", datasetSelector, "namespace", namespace, "error", err)
+			return NewSelectorBuilder().Dataset(namespace, name).Extra(extra).Build()
+		}
+		m.logger.Debug("dataset selector matched no workloads, falling back to release", "selector", datasetSelector, "namespace", namespace)
+	}
+
+	return NewSelectorBuilder().Release(name).Extra(extra).Build()
+}
+
+// discoveryResult collects the output of a single discovery category
+type discoveryResult struct {
+	resources []types.K8sResourceNode
+	warnings  []types.MappingWarning
+}
+
+// Slots of the discovery categories in discoverResources' results. Their
+// resources and warnings are merged in this order, whichever finishes first.
+const (
+	slotStatefulSets = iota
+	slotDaemonSets
+	slotStorage
+	slotConfigs
+	slotServices
+	slotAutoscalers
+	discoverySlots
+)
+
+// discoverResources discovers all K8s resources related to the dataset.
+// The discovery categories run concurrently; each writes only to its own
+// result slot, and the slots are joined in a fixed order afterwards.
+func (m *Mapper) discoverResources(ctx context.Context, namespace, releaseName, labelSelector string, runtime *types.RuntimeNode, opts Options) ([]types.K8sResourceNode, []types.MappingWarning) {
+	if warning, stop := interrupted(ctx, "resource discovery"); stop {
+		return nil, []types.MappingWarning{warning}
+	}
+
+	var results [discoverySlots]discoveryResult
+	var g errgroup.Group
 
-	labelSelector := fmt.Sprintf("release=%s", name)
+	// Pods are listed once, by whichever workload discovery needs them first
+	var pods *podSource
+	if opts.IncludePods && opts.kindEnabled(ResourceKinds.Pod) {
+		pods = &podSource{load: func() (*podIndex, []types.MappingWarning) {
+			return m.listPods(ctx, namespace, labelSelector, opts)
+		}}
+	}
+
+	// discover runs one discovery function in the group and logs its result
+	discover := func(slot int, kind string, fn func() ([]types.K8sResourceNode, []types.MappingWarning)) {
+		g.Go(func() error {
+			m.progress("Listing " + kind)
+			start := time.Now()
+			results[slot].resources, results[slot].warnings = fn()
+			m.logDiscovery(kind, namespace, labelSelector, len(results[slot].resources), time.Since(start))
+			return nil
+		})
+	}
 
 	// Discover StatefulSets (Master, Worker)
-	stsResources, stsWarnings := m.discoverStatefulSets(ctx, namespace, labelSelector, opts)
-	resources = append(resources, stsResources...)
-	warnings = append(warnings, stsWarnings...)
+	if opts.kindEnabled(ResourceKinds.StatefulSet) {
+		discover(slotStatefulSets, "StatefulSets", func() ([]types.K8sResourceNode, []types.MappingWarning) {
+			return m.discoverStatefulSets(ctx, namespace, labelSelector, pods)
+		})
+	}
 
 	// Discover DaemonSets (Fuse)
-	dsResources, dsWarnings := m.discoverDaemonSets(ctx, namespace, labelSelector, opts)
-	resources = append(resources, dsResources...)
-	warnings = append(warnings, dsWarnings...)
+	if opts.kindEnabled(ResourceKinds.DaemonSet) {
+		discover(slotDaemonSets, "DaemonSets", func() ([]types.K8sResourceNode, []types.MappingWarning) {
+			return m.discoverDaemonSets(ctx, namespace, labelSelector, pods)
+		})
+	}
+
+	// Discover Services (master, worker) and their endpoints
+	if opts.kindEnabled(ResourceKinds.Service) {
+		discover(slotServices, "Services", func() ([]types.K8sResourceNode, []types.MappingWarning) {
+			return m.discoverServices(ctx, namespace, labelSelector)
+		})
+	}
 
 	// Discover Storage resources
-	if opts.IncludeStorage {
-		storageResources, storageWarnings := m.discoverStorage(ctx, namespace, labelSelector)
-		resources = append(resources, storageResources...)
-		warnings = append(warnings, storageWarnings...)
+	if opts.IncludeStorage && (opts.kindEnabled(ResourceKinds.PersistentVolumeClaim) || opts.kindEnabled(ResourceKinds.PersistentVolume)) {
+		discover(slotStorage, "storage", func() ([]types.K8sResourceNode, []types.MappingWarning) {
+			return m.discoverStorage(ctx, namespace, labelSelector, opts)
+		})
 	}
 
 	// Discover Config resources
-	if opts.IncludeConfigs {
-		configResources, configWarnings := m.discoverConfigs(ctx, namespace, labelSelector)
-		resources = append(resources, configResources...)
-		warnings = append(warnings, configWarnings...)
+	if opts.IncludeConfigs && (opts.kindEnabled(ResourceKinds.ConfigMap) || opts.kindEnabled(ResourceKinds.Secret)) {
+		discover(slotConfigs, "configs", func() ([]types.K8sResourceNode, []types.MappingWarning) {
+			return m.discoverConfigs(ctx, namespace, labelSelector, opts)
+		})
+	}
+
+	// Discover HorizontalPodAutoscalers scaling the workers
+	if opts.IncludeAutoscalers && opts.kindEnabled(ResourceKinds.HorizontalPodAutoscaler) {
+		discover(slotAutoscalers, "HorizontalPodAutoscalers", func() ([]types.K8sResourceNode, []types.MappingWarning) {
+			return m.discoverAutoscalers(ctx, namespace, releaseName)
+		})
+	}
+
+	// The discovery functions report failures as warnings, so Wait never errors
+	_ = g.Wait()
+
+	var resources []types.K8sResourceNode
+	var warnings []types.MappingWarning
+	for _, result := range results {
+		resources = append(resources, result.resources...)
+		warnings = append(warnings, result.warnings...)
+	}
+
+	// Components the release selector found nothing for may just lack the release label
+	if runtime != nil && opts.ExtraLabelSelector == "" && ctx.Err() == nil {
+		recovered, recoveryWarnings := m.discoverByRuntimeType(ctx, namespace, runtime, resources, opts)
+		resources = append(resources, recovered...)
+		warnings = append(warnings, recoveryWarnings...)
+	}
+
+	if runtime != nil {
+		for i := range resources {
+			resources[i].Runtime = runtime.Name
+		}
 	}
 
 	return resources, warnings
 }
 
+// discoverByRuntimeType retries the workload discovery of the components the
+// release selector found nothing for with the app=<runtimeType> selector,
+// keeping only the workloads the runtime owns. Deployments with inconsistent
+// labeling carry app but not release on some resources; recovering them
+// avoids reporting those components missing.
+func (m *Mapper) discoverByRuntimeType(ctx context.Context, namespace string, runtime *types.RuntimeNode, found []types.K8sResourceNode, opts Options) ([]types.K8sResourceNode, []types.MappingWarning) {
+	expected := GetRuntimeComponents(runtime.Type)
+	missing := map[types.ComponentType]bool{
+		types.ComponentMaster: expected.HasMaster && len(filterByComponent(found, types.ComponentMaster)) == 0,
+		types.ComponentWorker: expected.HasWorker && len(filterByComponent(found, types.ComponentWorker)) == 0,
+		types.ComponentFuse:   expected.HasFuse && len(filterByComponent(found, types.ComponentFuse)) == 0,
+	}
+	needStatefulSets := opts.kindEnabled(ResourceKinds.StatefulSet) && (missing[types.ComponentMaster] || missing[types.ComponentWorker])
+	needDaemonSets := opts.kindEnabled(ResourceKinds.DaemonSet) && missing[types.ComponentFuse]
+	if !needStatefulSets && !needDaemonSets {
+		return nil, nil
+	}
+
+	selector := LabelSelectors.RuntimeType(string(runtime.Type))
+	m.logger.Debug("retrying discovery by runtime type", "runtime", runtime.Name, "selector", selector)
+
+	var pods *podSource
+	if opts.IncludePods && opts.kindEnabled(ResourceKinds.Pod) {
+		pods = &podSource{load: func() (*podIndex, []types.MappingWarning) {
+			return m.listPods(ctx, namespace, selector, opts)
+		}}
+	}
+
+	var candidates []types.K8sResourceNode
+	var warnings []types.MappingWarning
+	if needStatefulSets {
+		resources, w := m.discoverStatefulSets(ctx, namespace, selector, pods)
+		candidates = append(candidates, resources...)
+		warnings = append(warnings, w...)
+	}
+	if needDaemonSets {
+		resources, w := m.discoverDaemonSets(ctx, namespace, selector, pods)
+		candidates = append(candidates, resources...)
+		warnings = append(warnings, w...)
+	}
+
+	var recovered []types.K8sResourceNode
+	for _, r := range candidates {
+		if !missing[r.Component] || !ownedByRuntime(r, runtime) {
+			continue
+		}
+		recovered = append(recovered, r)
+		warnings = append(warnings, types.MappingWarning{
+			Level:      types.WarningLevelInfo,
+			Code:       types.WarningCodes.ReleaseLabelMissing,
+			Message:    fmt.Sprintf("%s %s was found by %s and its owner, not by the release selector", r.Kind, r.Name, selector),
+			Resource:   r.Name,
+			Suggestion: "Label the resource with release=" + runtime.Name + " so tools selecting by release find it",
+		})
+	}
+	return recovered, warnings
+}
+
+// ownedByRuntime reports whether a resource's owner is the runtime CR
+func ownedByRuntime(r types.K8sResourceNode, runtime *types.RuntimeNode) bool {
+	if r.Owner == nil || r.Owner.Name != runtime.Name {
+		return false
+	}
+	if runtime.Kind != "" {
+		return r.Owner.Kind == runtime.Kind
+	}
+	return strings.HasSuffix(r.Owner.Kind, "Runtime")
+}
+
 // discoverStatefulSets discovers StatefulSet resources (master, worker)
-func (m *Mapper) discoverStatefulSets(ctx context.Context, namespace, labelSelector string, opts Options) ([]types.K8sResourceNode, []types.MappingWarning) {
+func (m *Mapper) discoverStatefulSets(ctx context.Context, namespace, labelSelector string, source *podSource) ([]types.K8sResourceNode, []types.MappingWarning) {
 	var resources []types.K8sResourceNode
 	var warnings []types.MappingWarning
 
@@ -182,40 +861,70 @@ func (m *Mapper) discoverStatefulSets(ctx context.Context, namespace, labelSelec
 		return resources, warnings
 	}
 
+	// Attach the release's pods to each StatefulSet from the shared index
+	var pods *podIndex
+	if source != nil && len(stsList.Items) > 0 {
+		var podWarnings []types.MappingWarning
+		pods, podWarnings = source.get()
+		warnings = append(warnings, podWarnings...)
+	}
+
 	for _, sts := range stsList.Items {
+		if ctx.Err() != nil {
+			break
+		}
 		component := determineComponent(sts.Labels)
 		phase := types.PhaseReady
 		if sts.Status.ReadyReplicas < *sts.Spec.Replicas {
 			phase = types.PhaseNotReady
 		}
+		// Mid-rollout the replica count can look ready while pods still
+		// run the old revision
+		if sts.Status.CurrentRevision != "" && sts.Status.UpdateRevision != "" &&
+			sts.Status.CurrentRevision != sts.Status.UpdateRevision {
+			phase = types.PhaseUpdating
+		}
 
 		node := types.K8sResourceNode{
-			Kind:       "StatefulSet",
-			APIVersion: "apps/v1",
-			Name:       sts.Name,
-			Namespace:  sts.Namespace,
-			Component:  component,
+			Kind:              "StatefulSet",
+			APIVersion:        "apps/v1",
+			Name:              sts.Name,
+			Namespace:         sts.Namespace,
+			Component:         component,
+			DeletionTimestamp: deletionTimestamp(sts.DeletionTimestamp),
+			Terminating:       sts.DeletionTimestamp != nil,
 			Status: types.ResourceStatus{
-				Phase: phase,
-				Ready: fmt.Sprintf("%d/%d", sts.Status.ReadyReplicas, *sts.Spec.Replicas),
-				Age:   formatAge(sts.CreationTimestamp.Time),
+				Phase:     phase,
+				Ready:     fmt.Sprintf("%d/%d", sts.Status.ReadyReplicas, *sts.Spec.Replicas),
+				Age:       formatAge(sts.CreationTimestamp.Time),
+				CreatedAt: sts.CreationTimestamp.Time,
 			},
 			Labels: filterLabels(sts.Labels),
 		}
 
-		// Include owner info
-		if len(sts.OwnerReferences) > 0 {
-			node.Owner = &types.OwnerInfo{
-				Kind: sts.OwnerReferences[0].Kind,
-				Name: sts.OwnerReferences[0].Name,
-				UID:  string(sts.OwnerReferences[0].UID),
-			}
+		node.Details = map[string]string{
+			"desiredReplicas": strconv.Itoa(int(*sts.Spec.Replicas)),
+			"currentReplicas": strconv.Itoa(int(sts.Status.Replicas)),
+			"updatedReplicas": strconv.Itoa(int(sts.Status.UpdatedReplicas)),
+		}
+		if sts.Status.UpdateRevision != "" {
+			node.Details["updateRevision"] = sts.Status.UpdateRevision
+		}
+		if sts.Status.CurrentRevision != "" {
+			node.Details["currentRevision"] = sts.Status.CurrentRevision
+		}
+		if images := containerImages(sts.Spec.Template.Spec); images != "" {
+			node.Details["image"] = images
 		}
 
+		// Include owner info
+		node.Owner = ownerInfo(sts.OwnerReferences)
+
 		// Include pods as children if requested
-		if opts.IncludePods {
-			pods, _ := m.discoverPodsForWorkload(ctx, namespace, sts.Name)
-			node.Children = pods
+		if pods != nil {
+			children, podWarnings := m.discoverPodsForWorkload(ctx, pods, string(sts.UID), sts.Name)
+			node.Children = children
+			warnings = append(warnings, podWarnings...)
 		}
 
 		resources = append(resources, node)
@@ -225,7 +934,7 @@ func (m *Mapper) discoverStatefulSets(ctx context.Context, namespace, labelSelec
 }
 
 // discoverDaemonSets discovers DaemonSet resources (fuse)
-func (m *Mapper) discoverDaemonSets(ctx context.Context, namespace, labelSelector string, opts Options) ([]types.K8sResourceNode, []types.MappingWarning) {
+func (m *Mapper) discoverDaemonSets(ctx context.Context, namespace, labelSelector string, source *podSource) ([]types.K8sResourceNode, []types.MappingWarning) {
 	var resources []types.K8sResourceNode
 	var warnings []types.MappingWarning
 
@@ -239,6 +948,14 @@ func (m *Mapper) discoverDaemonSets(ctx context.Context, namespace, labelSelecto
 		return resources, warnings
 	}
 
+	// Attach the fuse pods to each DaemonSet from the shared index
+	var pods *podIndex
+	if source != nil && len(dsList.Items) > 0 {
+		var podWarnings []types.MappingWarning
+		pods, podWarnings = source.get()
+		warnings = append(warnings, podWarnings...)
+	}
+
 	for _, ds := range dsList.Items {
 		phase := types.PhaseReady
 		if ds.Status.NumberReady < ds.Status.DesiredNumberScheduled {
@@ -246,26 +963,33 @@ func (m *Mapper) discoverDaemonSets(ctx context.Context, namespace, labelSelecto
 		}
 
 		node := types.K8sResourceNode{
-			Kind:       "DaemonSet",
-			APIVersion: "apps/v1",
-			Name:       ds.Name,
-			Namespace:  ds.Namespace,
-			Component:  types.ComponentFuse,
+			Kind:              "DaemonSet",
+			APIVersion:        "apps/v1",
+			Name:              ds.Name,
+			Namespace:         ds.Namespace,
+			Component:         types.ComponentFuse,
+			DeletionTimestamp: deletionTimestamp(ds.DeletionTimestamp),
+			Terminating:       ds.DeletionTimestamp != nil,
 			Status: types.ResourceStatus{
-				Phase: phase,
-				Ready: fmt.Sprintf("%d/%d", ds.Status.NumberReady, ds.Status.DesiredNumberScheduled),
-				Age:   formatAge(ds.CreationTimestamp.Time),
+				Phase:     phase,
+				Ready:     fmt.Sprintf("%d/%d", ds.Status.NumberReady, ds.Status.DesiredNumberScheduled),
+				Age:       formatAge(ds.CreationTimestamp.Time),
+				CreatedAt: ds.CreationTimestamp.Time,
 			},
 			Labels: filterLabels(ds.Labels),
 		}
 
+		if images := containerImages(ds.Spec.Template.Spec); images != "" {
+			node.Details = map[string]string{"image": images}
+		}
+
 		// Include owner info
-		if len(ds.OwnerReferences) > 0 {
-			node.Owner = &types.OwnerInfo{
-				Kind: ds.OwnerReferences[0].Kind,
-				Name: ds.OwnerReferences[0].Name,
-				UID:  string(ds.OwnerReferences[0].UID),
-			}
+		node.Owner = ownerInfo(ds.OwnerReferences)
+
+		if pods != nil {
+			children, podWarnings := m.discoverPodsForWorkload(ctx, pods, string(ds.UID), ds.Name)
+			node.Children = children
+			warnings = append(warnings, podWarnings...)
 		}
 
 		resources = append(resources, node)
@@ -274,54 +998,438 @@ func (m *Mapper) discoverDaemonSets(ctx context.Context, namespace, labelSelecto
 	return resources, warnings
 }
 
-// discoverPodsForWorkload discovers pods owned by a workload
-func (m *Mapper) discoverPodsForWorkload(ctx context.Context, namespace, workloadName string) ([]types.K8sResourceNode, []types.MappingWarning) {
-	var resources []types.K8sResourceNode
-	var warnings []types.MappingWarning
+// logDiscovery logs the result of one discovery step. Empty results are
+// logged at Info with the exact query, since they usually explain a surprise.
+func (m *Mapper) logDiscovery(kind, namespace, labelSelector string, count int, elapsed time.Duration) {
+	if count == 0 {
+		m.logger.Info("discovery matched no resources", "kind", kind, "namespace", namespace, "selector", labelSelector, "duration", elapsed)
+		return
+	}
+	m.logger.Debug("discovered resources", "kind", kind, "namespace", namespace, "selector", labelSelector, "count", count, "duration", elapsed)
+}
 
-	// Get all pods and filter by owner
-	podList, err := m.client.ListPods(ctx, namespace, "")
+// podSource lists the release's pods at most once and shares the resulting
+// index between the StatefulSet and DaemonSet discovery goroutines
+type podSource struct {
+	once sync.Once
+	load func() (*podIndex, []types.MappingWarning)
+	pods *podIndex
+}
+
+// get returns the pod index, listing the pods on first use. Listing warnings
+// are returned only to the caller that performed the listing.
+func (s *podSource) get() (*podIndex, []types.MappingWarning) {
+	var warnings []types.MappingWarning
+	s.once.Do(func() {
+		s.pods, warnings = s.load()
+	})
+	return s.pods, warnings
+}
+
+// listPods lists the release's pods into an index, with live usage if requested
+func (m *Mapper) listPods(ctx context.Context, namespace, labelSelector string, opts Options) (*podIndex, []types.MappingWarning) {
+	m.progress("Resolving pods")
+	podList, err := m.client.ListPods(ctx, namespace, labelSelector, opts.PodFieldSelector)
 	if err != nil {
-		return resources, warnings
+		return nil, []types.MappingWarning{{
+			Level:   types.WarningLevelWarning,
+			Code:    types.WarningCodes.PodListFailed,
+			Message: fmt.Sprintf("Failed to list Pods: %v", err),
+		}}
 	}
 
-	for _, pod := range podList.Items {
-		// Check if pod is owned by this workload
-		isOwned := false
+	pods := newPodIndex(podList.Items)
+	var warnings []types.MappingWarning
+	if opts.IncludeUsage {
+		pods.usage, warnings = m.discoverPodUsage(ctx, namespace, labelSelector)
+	}
+	return pods, warnings
+}
+
+// podIndex indexes a pod listing by owner UID and by StatefulSet name prefix
+type podIndex struct {
+	byOwnerUID map[string][]corev1.Pod
+	byPrefix   map[string][]corev1.Pod
+
+	// usage holds live pod usage by pod name when IncludeUsage is set
+	usage map[string]corev1.ResourceList
+}
+
+// newPodIndex builds a podIndex from a single pod listing
+func newPodIndex(pods []corev1.Pod) *podIndex {
+	idx := &podIndex{
+		byOwnerUID: make(map[string][]corev1.Pod),
+		byPrefix:   make(map[string][]corev1.Pod),
+	}
+	for _, pod := range pods {
 		for _, ref := range pod.OwnerReferences {
-			if ref.Name == workloadName {
-				isOwned = true
-				break
-			}
+			idx.byOwnerUID[string(ref.UID)] = append(idx.byOwnerUID[string(ref.UID)], pod)
 		}
-		// For statefulsets, check naming convention
-		if !isOwned && len(pod.Name) > len(workloadName) && pod.Name[:len(workloadName)] == workloadName {
-			isOwned = true
+		if prefix := statefulSetPodPrefix(pod.Name); prefix != "" {
+			idx.byPrefix[prefix] = append(idx.byPrefix[prefix], pod)
 		}
+	}
+	return idx
+}
 
-		if !isOwned {
-			continue
+// podsFor returns the pods owned by the workload, falling back to the
+// StatefulSet naming convention (<name>-<ordinal>) for pods without owners
+func (idx *podIndex) podsFor(ownerUID, workloadName string) []corev1.Pod {
+	if pods := idx.byOwnerUID[ownerUID]; ownerUID != "" && len(pods) > 0 {
+		return pods
+	}
+	var pods []corev1.Pod
+	for _, pod := range idx.byPrefix[workloadName] {
+		if len(pod.OwnerReferences) == 0 {
+			pods = append(pods, pod)
 		}
+	}
+	return pods
+}
+
+// statefulSetPodPrefix returns the StatefulSet name for a pod named <name>-<ordinal>
+func statefulSetPodPrefix(podName string) string {
+	i := strings.LastIndex(podName, "-")
+	if i <= 0 {
+		return ""
+	}
+	if _, err := strconv.Atoi(podName[i+1:]); err != nil {
+		return ""
+	}
+	return podName[:i]
+}
 
+// discoverPodsForWorkload discovers pods owned by a workload from the pod index
+func (m *Mapper) discoverPodsForWorkload(ctx context.Context, pods *podIndex, ownerUID, workloadName string) ([]types.K8sResourceNode, []types.MappingWarning) {
+	var resources []types.K8sResourceNode
+	var warnings []types.MappingWarning
+
+	for _, pod := range pods.podsFor(ownerUID, workloadName) {
 		phase := types.PhaseReady
 		if pod.Status.Phase != "Running" {
 			phase = types.ResourcePhase(pod.Status.Phase)
 		}
+		message := string(pod.Status.Phase)
+
+		// A Pending pod is often still initializing; show how far its init
+		// containers got like kubectl does, e.g. Init:1/2 or Init:ImagePullBackOff
+		var initStuck *corev1.ContainerStatus
+		if pod.Status.Phase == corev1.PodPending {
+			var initReason string
+			message, initStuck, initReason = initContainerStatus(pod, message)
+			if initStuck != nil {
+				phase = types.PhaseNotReady
+				if failedWaitingReasons[initReason] || initStuck.State.Terminated != nil {
+					phase = types.PhaseFailed
+				}
+				warnings = append(warnings, types.MappingWarning{
+					Level:      types.WarningLevelWarning,
+					Code:       types.WarningCodes.PodsNotReady,
+					Message:    fmt.Sprintf("Pod %s init container %s is %s (%d restarts)", pod.Name, initStuck.Name, initReason, initStuck.RestartCount),
+					Resource:   pod.Name,
+					Suggestion: waitingSuggestion(initReason),
+				})
+			}
+		}
+
+		// A Running or Pending phase hides containers stuck crash-looping or pulling images
+		waiting := waitingContainer(pod)
+		if waiting != nil && (pod.Status.Phase == corev1.PodRunning || pod.Status.Phase == corev1.PodPending) {
+			phase = types.PhaseNotReady
+			if failedWaitingReasons[waiting.State.Waiting.Reason] {
+				phase = types.PhaseFailed
+			}
+			message = waiting.State.Waiting.Reason
+			warnings = append(warnings, types.MappingWarning{
+				Level:      types.WarningLevelWarning,
+				Code:       types.WarningCodes.PodsNotReady,
+				Message:    fmt.Sprintf("Pod %s container %s is %s (%d restarts)", pod.Name, waiting.Name, waiting.State.Waiting.Reason, waiting.RestartCount),
+				Resource:   pod.Name,
+				Suggestion: waitingSuggestion(waiting.State.Waiting.Reason),
+			})
+		}
 
 		node := types.K8sResourceNode{
-			Kind:       "Pod",
-			APIVersion: "v1",
-			Name:       pod.Name,
-			Namespace:  pod.Namespace,
-			Component:  determineComponent(pod.Labels),
+			Kind:              "Pod",
+			APIVersion:        "v1",
+			Name:              pod.Name,
+			Namespace:         pod.Namespace,
+			Component:         determineComponent(pod.Labels),
+			Node:              pod.Spec.NodeName,
+			DeletionTimestamp: deletionTimestamp(pod.DeletionTimestamp),
+			Terminating:       pod.DeletionTimestamp != nil,
 			Status: types.ResourceStatus{
-				Phase:   phase,
-				Message: string(pod.Status.Phase),
-				Age:     formatAge(pod.CreationTimestamp.Time),
+				Phase:     phase,
+				Message:   message,
+				Age:       formatAge(pod.CreationTimestamp.Time),
+				CreatedAt: pod.CreationTimestamp.Time,
 			},
 			Labels: filterLabels(pod.Labels),
 		}
 
+		node.Owner = ownerInfo(pod.OwnerReferences)
+
+		node.Details = podResourceDetails(pod)
+		if revision := pod.Labels[appsv1.StatefulSetRevisionLabel]; revision != "" {
+			node.Details["controllerRevision"] = revision
+		}
+		if waiting != nil {
+			node.Details["waitingReason"] = waiting.State.Waiting.Reason
+		}
+		if initStuck != nil {
+			node.Details["initContainer"] = initStuck.Name
+		}
+		if images := containerImages(pod.Spec); images != "" {
+			node.Details["image"] = images
+		}
+		if ephemeral := ephemeralContainers(pod); ephemeral != "" {
+			node.Details["ephemeralContainers"] = ephemeral
+		}
+		if restarts := restartCount(pod); restarts > 0 {
+			node.Details["restartCount"] = strconv.Itoa(int(restarts))
+		}
+		if usage, ok := pods.usage[pod.Name]; ok {
+			for key, value := range usageDetails(usage) {
+				node.Details[key] = value
+			}
+		}
+		if len(node.Details) == 0 {
+			node.Details = nil
+		}
+
+		// Events usually explain why a pod is stuck (FailedScheduling, BackOff, ...)
+		if phase != types.PhaseReady {
+			events, err := m.discoverPodEvents(ctx, pod.Namespace, pod.Name)
+			if err != nil {
+				warnings = append(warnings, types.MappingWarning{
+					Level:    types.WarningLevelInfo,
+					Code:     types.WarningCodes.EventListFailed,
+					Message:  fmt.Sprintf("Failed to list Events for pod %s: %v", pod.Name, err),
+					Resource: pod.Name,
+				})
+			}
+			node.Events = events
+		}
+
+		resources = append(resources, node)
+	}
+
+	return resources, warnings
+}
+
+// Container waiting reasons that mean a pod is broken rather than just slow:
+// failedWaitingReasons make the pod Failed, the others NotReady
+var (
+	failedWaitingReasons = map[string]bool{
+		"CrashLoopBackOff":           true,
+		"CreateContainerConfigError": true,
+		"CreateContainerError":       true,
+		"InvalidImageName":           true,
+		"RunContainerError":          true,
+	}
+	notReadyWaitingReasons = map[string]bool{
+		"ImagePullBackOff": true,
+		"ErrImagePull":     true,
+	}
+)
+
+// waitingContainer returns the first container stuck waiting for a problem
+// reason such as CrashLoopBackOff or ImagePullBackOff, or nil
+func waitingContainer(pod corev1.Pod) *corev1.ContainerStatus {
+	for i := range pod.Status.ContainerStatuses {
+		status := &pod.Status.ContainerStatuses[i]
+		if status.State.Waiting == nil {
+			continue
+		}
+		reason := status.State.Waiting.Reason
+		if failedWaitingReasons[reason] || notReadyWaitingReasons[reason] {
+			return status
+		}
+	}
+	return nil
+}
+
+// initContainerStatus describes the init progress of a Pending pod the way
+// kubectl does: "Init:<reason>" when an init container failed or is stuck
+// waiting for a problem reason, "Init:<done>/<total>" while one is still
+// running, and fallback once all have completed. The stuck init container
+// and its reason are returned too, or nil when none is stuck.
+func initContainerStatus(pod corev1.Pod, fallback string) (string, *corev1.ContainerStatus, string) {
+	total := len(pod.Spec.InitContainers)
+	if total == 0 {
+		total = len(pod.Status.InitContainerStatuses)
+	}
+	for i := range pod.Status.InitContainerStatuses {
+		status := &pod.Status.InitContainerStatuses[i]
+		switch {
+		case status.State.Terminated != nil && status.State.Terminated.ExitCode == 0:
+			continue
+		case status.State.Terminated != nil:
+			reason := status.State.Terminated.Reason
+			if reason == "" {
+				reason = fmt.Sprintf("ExitCode:%d", status.State.Terminated.ExitCode)
+			}
+			return "Init:" + reason, status, reason
+		case status.State.Waiting != nil && (failedWaitingReasons[status.State.Waiting.Reason] || notReadyWaitingReasons[status.State.Waiting.Reason]):
+			return "Init:" + status.State.Waiting.Reason, status, status.State.Waiting.Reason
+		default:
+			return fmt.Sprintf("Init:%d/%d", i, total), nil, ""
+		}
+	}
+	return fallback, nil, ""
+}
+
+// containerImages lists the distinct images of a pod spec's containers in
+// order, e.g. "alluxio/alluxio:2.9.0, alluxio/alluxio-fuse:2.9.0". Init and
+// ephemeral containers are left out: they do not say what the pod serves.
+func containerImages(spec corev1.PodSpec) string {
+	var images []string
+	seen := make(map[string]bool)
+	for _, c := range spec.Containers {
+		if c.Image == "" || seen[c.Image] {
+			continue
+		}
+		seen[c.Image] = true
+		images = append(images, c.Image)
+	}
+	return strings.Join(images, ", ")
+}
+
+// ephemeralContainers lists a pod's ephemeral debug containers with their
+// state, e.g. "debugger-x7k2 (Running)", or "" when there are none
+func ephemeralContainers(pod corev1.Pod) string {
+	var containers []string
+	for _, status := range pod.Status.EphemeralContainerStatuses {
+		state := "Waiting"
+		switch {
+		case status.State.Running != nil:
+			state = "Running"
+		case status.State.Terminated != nil:
+			state = "Terminated: " + status.State.Terminated.Reason
+		case status.State.Waiting != nil && status.State.Waiting.Reason != "":
+			state = status.State.Waiting.Reason
+		}
+		containers = append(containers, fmt.Sprintf("%s (%s)", status.Name, state))
+	}
+	return strings.Join(containers, ", ")
+}
+
+// restartCount sums the restarts of a pod's containers
+func restartCount(pod corev1.Pod) int32 {
+	var restarts int32
+	for _, status := range pod.Status.ContainerStatuses {
+		restarts += status.RestartCount
+	}
+	return restarts
+}
+
+// waitingSuggestion returns the next step for a container waiting reason
+func waitingSuggestion(reason string) string {
+	switch {
+	case notReadyWaitingReasons[reason] || reason == "InvalidImageName":
+		return "Check the image name and tag, and the registry credentials (imagePullSecrets)"
+	case reason == "CrashLoopBackOff":
+		return "Check the container logs, including the previous run (kubectl logs --previous)"
+	default:
+		return "Check the pod events and the referenced ConfigMaps and Secrets"
+	}
+}
+
+// discoverPodEvents returns the latest maxPodEvents Events of a pod, oldest first
+func (m *Mapper) discoverPodEvents(ctx context.Context, namespace, podName string) ([]string, error) {
+	eventList, err := m.client.ListEvents(ctx, namespace, podName)
+	if err != nil {
+		return nil, err
+	}
+
+	items := eventList.Items
+	sort.SliceStable(items, func(i, j int) bool {
+		return eventTime(items[i]).Before(eventTime(items[j]))
+	})
+	if len(items) > maxPodEvents {
+		items = items[len(items)-maxPodEvents:]
+	}
+
+	events := make([]string, 0, len(items))
+	for _, e := range items {
+		events = append(events, fmt.Sprintf("%s: %s", e.Reason, e.Message))
+	}
+	return events, nil
+}
+
+// eventTime returns when an Event last occurred, falling back to newer and older timestamp fields
+func eventTime(e corev1.Event) time.Time {
+	switch {
+	case !e.LastTimestamp.IsZero():
+		return e.LastTimestamp.Time
+	case !e.EventTime.IsZero():
+		return e.EventTime.Time
+	default:
+		return e.CreationTimestamp.Time
+	}
+}
+
+// discoverServices discovers Service resources and counts their ready endpoints
+func (m *Mapper) discoverServices(ctx context.Context, namespace, labelSelector string) ([]types.K8sResourceNode, []types.MappingWarning) {
+	var resources []types.K8sResourceNode
+	var warnings []types.MappingWarning
+
+	svcList, err := m.client.ListServices(ctx, namespace, labelSelector)
+	if err != nil {
+		warnings = append(warnings, types.MappingWarning{
+			Level:   types.WarningLevelWarning,
+			Code:    types.WarningCodes.ServiceListFailed,
+			Message: fmt.Sprintf("Failed to list Services: %v", err),
+		})
+		return resources, warnings
+	}
+
+	for _, svc := range svcList.Items {
+		if ctx.Err() != nil {
+			break
+		}
+
+		node := types.K8sResourceNode{
+			Kind:              "Service",
+			APIVersion:        "v1",
+			Name:              svc.Name,
+			Namespace:         svc.Namespace,
+			Component:         determineComponent(svc.Labels),
+			DeletionTimestamp: deletionTimestamp(svc.DeletionTimestamp),
+			Terminating:       svc.DeletionTimestamp != nil,
+			Status: types.ResourceStatus{
+				Phase:     types.PhaseReady,
+				Age:       formatAge(svc.CreationTimestamp.Time),
+				CreatedAt: svc.CreationTimestamp.Time,
+			},
+			Labels: filterLabels(svc.Labels),
+		}
+
+		node.Owner = ownerInfo(svc.OwnerReferences)
+
+		endpoints, err := m.client.GetEndpoints(ctx, svc.Namespace, svc.Name)
+		if err != nil {
+			warnings = append(warnings, types.MappingWarning{
+				Level:    types.WarningLevelInfo,
+				Code:     types.WarningCodes.EndpointsGetFailed,
+				Message:  fmt.Sprintf("Failed to get Endpoints for Service %s: %v", svc.Name, err),
+				Resource: svc.Name,
+			})
+		} else {
+			ready, notReady := 0, 0
+			for _, subset := range endpoints.Subsets {
+				ready += len(subset.Addresses)
+				notReady += len(subset.NotReadyAddresses)
+			}
+			if ready == 0 {
+				node.Status.Phase = types.PhaseNotReady
+			}
+			node.Status.Ready = fmt.Sprintf("%d/%d", ready, ready+notReady)
+			node.Details = map[string]string{
+				"endpoints": fmt.Sprintf("%d/%d ready", ready, ready+notReady),
+			}
+		}
+
 		resources = append(resources, node)
 	}
 
@@ -329,7 +1437,9 @@ func (m *Mapper) discoverPodsForWorkload(ctx context.Context, namespace, workloa
 }
 
 // discoverStorage discovers PVC and PV resources
-func (m *Mapper) discoverStorage(ctx context.Context, namespace, labelSelector string) ([]types.K8sResourceNode, []types.MappingWarning) {
+// PVCs are listed whenever either storage kind is enabled, since PVs are
+// reached through their claims.
+func (m *Mapper) discoverStorage(ctx context.Context, namespace, labelSelector string, opts Options) ([]types.K8sResourceNode, []types.MappingWarning) {
 	var resources []types.K8sResourceNode
 	var warnings []types.MappingWarning
 
@@ -343,61 +1453,247 @@ func (m *Mapper) discoverStorage(ctx context.Context, namespace, labelSelector s
 		return resources, warnings
 	}
 
+	var consumers map[string][]types.K8sResourceNode
+	if opts.IncludeConsumers && opts.kindEnabled(ResourceKinds.Pod) {
+		var consumerWarnings []types.MappingWarning
+		consumers, consumerWarnings = m.discoverConsumers(ctx, namespace, pvcList.Items)
+		warnings = append(warnings, consumerWarnings...)
+	}
+
+	// A PV is added once; later claims on it are recorded in its "claims" detail
+	pvIndex := make(map[string]int)
+
+	// StorageClasses referenced by the claims and volumes, with the Pending claims using each
+	var classes []string
+	pending := make(map[string][]string)
+	addClass := func(class, pendingClaim string) {
+		if class == "" {
+			return
+		}
+		if _, seen := pending[class]; !seen {
+			classes = append(classes, class)
+			pending[class] = nil
+		}
+		if pendingClaim != "" {
+			pending[class] = append(pending[class], pendingClaim)
+		}
+	}
+
 	for _, pvc := range pvcList.Items {
+		if ctx.Err() != nil {
+			break
+		}
 		phase := types.PhaseBound
 		if pvc.Status.Phase != "Bound" {
 			phase = types.PhaseNotBound
 		}
 
 		node := types.K8sResourceNode{
-			Kind:       "PersistentVolumeClaim",
-			APIVersion: "v1",
-			Name:       pvc.Name,
-			Namespace:  pvc.Namespace,
-			Component:  types.ComponentStorage,
+			Kind:              "PersistentVolumeClaim",
+			APIVersion:        "v1",
+			Name:              pvc.Name,
+			Namespace:         pvc.Namespace,
+			Component:         types.ComponentStorage,
+			DeletionTimestamp: deletionTimestamp(pvc.DeletionTimestamp),
+			Terminating:       pvc.DeletionTimestamp != nil,
 			Status: types.ResourceStatus{
-				Phase: phase,
-				Age:   formatAge(pvc.CreationTimestamp.Time),
+				Phase:     phase,
+				Age:       formatAge(pvc.CreationTimestamp.Time),
+				CreatedAt: pvc.CreationTimestamp.Time,
 			},
 			Details: map[string]string{
 				"volumeName": pvc.Spec.VolumeName,
 			},
 		}
+		if modes := accessModes(pvc.Spec.AccessModes); modes != "" {
+			node.Details["accessModes"] = modes
+		}
+		if pvc.Spec.StorageClassName != nil {
+			node.Details["storageClass"] = *pvc.Spec.StorageClassName
+			pendingClaim := ""
+			if pvc.Status.Phase == corev1.ClaimPending {
+				pendingClaim = pvc.Name
+			}
+			addClass(*pvc.Spec.StorageClassName, pendingClaim)
+		}
+		setQuantity(node.Details, "requested", pvc.Spec.Resources.Requests, corev1.ResourceStorage)
+		setQuantity(node.Details, "capacity", pvc.Status.Capacity, corev1.ResourceStorage)
+		node.Children = consumers[pvc.Name]
 
-		resources = append(resources, node)
+		if opts.kindEnabled(ResourceKinds.PersistentVolumeClaim) {
+			resources = append(resources, node)
+		}
 
 		// If PVC is bound, include the PV
-		if pvc.Spec.VolumeName != "" {
+		if pvc.Spec.VolumeName != "" && opts.kindEnabled(ResourceKinds.PersistentVolume) {
+			if i, seen := pvIndex[pvc.Spec.VolumeName]; seen {
+				resources[i].Details["claims"] += "," + pvc.Name
+				continue
+			}
 			pv, err := m.client.GetPV(ctx, pvc.Spec.VolumeName)
 			if err == nil {
 				pvNode := types.K8sResourceNode{
-					Kind:       "PersistentVolume",
-					APIVersion: "v1",
-					Name:       pv.Name,
-					Component:  types.ComponentStorage,
+					Kind:              "PersistentVolume",
+					APIVersion:        "v1",
+					Name:              pv.Name,
+					Component:         types.ComponentStorage,
+					DeletionTimestamp: deletionTimestamp(pv.DeletionTimestamp),
+					Terminating:       pv.DeletionTimestamp != nil,
 					Status: types.ResourceStatus{
-						Phase: types.ResourcePhase(pv.Status.Phase),
-						Age:   formatAge(pv.CreationTimestamp.Time),
+						Phase:     types.ResourcePhase(pv.Status.Phase),
+						Age:       formatAge(pv.CreationTimestamp.Time),
+						CreatedAt: pv.CreationTimestamp.Time,
 					},
 					Owner: &types.OwnerInfo{
 						Kind: "PersistentVolumeClaim",
 						Name: pvc.Name,
 					},
+					Details: map[string]string{
+						"claims": pvc.Name,
+					},
+				}
+				if modes := accessModes(pv.Spec.AccessModes); modes != "" {
+					pvNode.Details["accessModes"] = modes
+				}
+				if pv.Spec.PersistentVolumeReclaimPolicy != "" {
+					pvNode.Details["reclaimPolicy"] = string(pv.Spec.PersistentVolumeReclaimPolicy)
 				}
+				if pv.Spec.StorageClassName != "" {
+					pvNode.Details["storageClass"] = pv.Spec.StorageClassName
+					addClass(pv.Spec.StorageClassName, "")
+				}
+				if ref := pv.Spec.ClaimRef; ref != nil {
+					pvNode.Details["claimRef"] = ref.Name
+					if ref.Namespace != "" {
+						pvNode.Details["claimRef"] = ref.Namespace + "/" + ref.Name
+					}
+				}
+				setQuantity(pvNode.Details, "capacity", pv.Spec.Capacity, corev1.ResourceStorage)
+				pvIndex[pv.Name] = len(resources)
 				resources = append(resources, pvNode)
 			}
 		}
 	}
 
+	if opts.IncludeStorageClasses && opts.kindEnabled(ResourceKinds.StorageClass) {
+		classNodes, classWarnings := m.discoverStorageClasses(ctx, classes, pending)
+		resources = append(resources, classNodes...)
+		warnings = append(warnings, classWarnings...)
+	}
+
 	return resources, warnings
 }
 
+// discoverStorageClasses fetches the StorageClasses behind the Dataset's
+// volumes and explains Pending claims: a missing class, or one that waits
+// for a consuming pod before binding. A missing class is only reported for
+// Pending claims, since a bound static PV such as Fluid's needs no class object.
+func (m *Mapper) discoverStorageClasses(ctx context.Context, classes []string, pending map[string][]string) ([]types.K8sResourceNode, []types.MappingWarning) {
+	var resources []types.K8sResourceNode
+	var warnings []types.MappingWarning
+
+	for _, class := range classes {
+		if ctx.Err() != nil {
+			break
+		}
+		claims := strings.Join(pending[class], ", ")
+
+		sc, err := m.client.GetStorageClass(ctx, class)
+		if err != nil {
+			if !apierrors.IsNotFound(err) {
+				warnings = append(warnings, types.MappingWarning{
+					Level:    types.WarningLevelInfo,
+					Code:     types.WarningCodes.StorageClassGetFailed,
+					Message:  fmt.Sprintf("Failed to get StorageClass %s: %v", class, err),
+					Resource: class,
+				})
+			} else if claims != "" {
+				warnings = append(warnings, types.MappingWarning{
+					Level:      types.WarningLevelWarning,
+					Code:       types.WarningCodes.StorageClassMissing,
+					Message:    fmt.Sprintf("StorageClass %s does not exist, so PVC %s cannot bind", class, claims),
+					Resource:   class,
+					Suggestion: "Create the StorageClass or set the PVC's storageClassName to an existing one",
+				})
+			}
+			continue
+		}
+
+		node := types.K8sResourceNode{
+			Kind:              ResourceKinds.StorageClass,
+			APIVersion:        "storage.k8s.io/v1",
+			Name:              sc.Name,
+			Component:         types.ComponentStorage,
+			DeletionTimestamp: deletionTimestamp(sc.DeletionTimestamp),
+			Terminating:       sc.DeletionTimestamp != nil,
+			Status: types.ResourceStatus{
+				Phase:     types.PhaseReady,
+				Age:       formatAge(sc.CreationTimestamp.Time),
+				CreatedAt: sc.CreationTimestamp.Time,
+			},
+			Details: map[string]string{
+				"provisioner": sc.Provisioner,
+			},
+		}
+		if sc.VolumeBindingMode != nil {
+			node.Details["volumeBindingMode"] = string(*sc.VolumeBindingMode)
+		}
+		if sc.ReclaimPolicy != nil {
+			node.Details["reclaimPolicy"] = string(*sc.ReclaimPolicy)
+		}
+		for key, value := range sc.Parameters {
+			node.Details["parameters."+key] = value
+		}
+		resources = append(resources, node)
+
+		if claims != "" && sc.VolumeBindingMode != nil && *sc.VolumeBindingMode == storagev1.VolumeBindingWaitForFirstConsumer {
+			warnings = append(warnings, types.MappingWarning{
+				Level:      types.WarningLevelWarning,
+				Code:       types.WarningCodes.WaitingForConsumer,
+				Message:    fmt.Sprintf("PVC %s is Pending: StorageClass %s binds volumes only when a pod using the claim is scheduled", claims, class),
+				Resource:   class,
+				Suggestion: "The claim binds once a pod using it is scheduled; check that such a pod exists and can be scheduled",
+			})
+		}
+	}
+
+	return resources, warnings
+}
+
+// accessModes joins volume access modes, e.g. "ReadWriteMany,ReadOnlyMany"
+func accessModes(modes []corev1.PersistentVolumeAccessMode) string {
+	names := make([]string, 0, len(modes))
+	for _, mode := range modes {
+		names = append(names, string(mode))
+	}
+	return strings.Join(names, ",")
+}
+
 // discoverConfigs discovers ConfigMap and Secret resources
-func (m *Mapper) discoverConfigs(ctx context.Context, namespace, labelSelector string) ([]types.K8sResourceNode, []types.MappingWarning) {
+func (m *Mapper) discoverConfigs(ctx context.Context, namespace, labelSelector string, opts Options) ([]types.K8sResourceNode, []types.MappingWarning) {
 	var resources []types.K8sResourceNode
 	var warnings []types.MappingWarning
 
 	// ConfigMaps
+	if opts.kindEnabled(ResourceKinds.ConfigMap) {
+		resources, warnings = m.discoverConfigMaps(ctx, namespace, labelSelector, opts.configDenylist())
+	}
+
+	// Secrets
+	if opts.kindEnabled(ResourceKinds.Secret) {
+		secrets, secretWarnings := m.discoverSecrets(ctx, namespace, labelSelector, opts.configDenylist())
+		resources = append(resources, secrets...)
+		warnings = append(warnings, secretWarnings...)
+	}
+
+	return resources, warnings
+}
+
+// discoverConfigMaps discovers the release's ConfigMaps, skipping denylisted names
+func (m *Mapper) discoverConfigMaps(ctx context.Context, namespace, labelSelector string, denylist []string) ([]types.K8sResourceNode, []types.MappingWarning) {
+	var resources []types.K8sResourceNode
+	var warnings []types.MappingWarning
+
 	cmList, err := m.client.ListConfigMaps(ctx, namespace, labelSelector)
 	if err != nil {
 		warnings = append(warnings, types.MappingWarning{
@@ -407,15 +1703,22 @@ func (m *Mapper) discoverConfigs(ctx context.Context, namespace, labelSelector s
 		})
 	} else {
 		for _, cm := range cmList.Items {
+			if denied(denylist, cm.Name) {
+				m.logger.Debug("skipping denylisted ConfigMap", "name", cm.Name)
+				continue
+			}
 			node := types.K8sResourceNode{
-				Kind:       "ConfigMap",
-				APIVersion: "v1",
-				Name:       cm.Name,
-				Namespace:  cm.Namespace,
-				Component:  types.ComponentConfig,
+				Kind:              "ConfigMap",
+				APIVersion:        "v1",
+				Name:              cm.Name,
+				Namespace:         cm.Namespace,
+				Component:         types.ComponentConfig,
+				DeletionTimestamp: deletionTimestamp(cm.DeletionTimestamp),
+				Terminating:       cm.DeletionTimestamp != nil,
 				Status: types.ResourceStatus{
-					Phase: types.PhaseReady,
-					Age:   formatAge(cm.CreationTimestamp.Time),
+					Phase:     types.PhaseReady,
+					Age:       formatAge(cm.CreationTimestamp.Time),
+					CreatedAt: cm.CreationTimestamp.Time,
 				},
 				Details: map[string]string{
 					"keys": fmt.Sprintf("%d", len(cm.Data)),
@@ -425,7 +1728,14 @@ func (m *Mapper) discoverConfigs(ctx context.Context, namespace, labelSelector s
 		}
 	}
 
-	// Secrets
+	return resources, warnings
+}
+
+// discoverSecrets discovers the release's Secrets, skipping denylisted names and types
+func (m *Mapper) discoverSecrets(ctx context.Context, namespace, labelSelector string, denylist []string) ([]types.K8sResourceNode, []types.MappingWarning) {
+	var resources []types.K8sResourceNode
+	var warnings []types.MappingWarning
+
 	secretList, err := m.client.ListSecrets(ctx, namespace, labelSelector)
 	if err != nil {
 		warnings = append(warnings, types.MappingWarning{
@@ -435,15 +1745,22 @@ func (m *Mapper) discoverConfigs(ctx context.Context, namespace, labelSelector s
 		})
 	} else {
 		for _, secret := range secretList.Items {
+			if denied(denylist, secret.Name, string(secret.Type)) {
+				m.logger.Debug("skipping denylisted Secret", "name", secret.Name, "type", secret.Type)
+				continue
+			}
 			node := types.K8sResourceNode{
-				Kind:       "Secret",
-				APIVersion: "v1",
-				Name:       secret.Name,
-				Namespace:  secret.Namespace,
-				Component:  types.ComponentConfig,
+				Kind:              "Secret",
+				APIVersion:        "v1",
+				Name:              secret.Name,
+				Namespace:         secret.Namespace,
+				Component:         types.ComponentConfig,
+				DeletionTimestamp: deletionTimestamp(secret.DeletionTimestamp),
+				Terminating:       secret.DeletionTimestamp != nil,
 				Status: types.ResourceStatus{
-					Phase: types.PhaseReady,
-					Age:   formatAge(secret.CreationTimestamp.Time),
+					Phase:     types.PhaseReady,
+					Age:       formatAge(secret.CreationTimestamp.Time),
+					CreatedAt: secret.CreationTimestamp.Time,
 				},
 				Details: map[string]string{
 					"type": string(secret.Type),
@@ -458,92 +1775,619 @@ func (m *Mapper) discoverConfigs(ctx context.Context, namespace, labelSelector s
 }
 
 // detectWarnings analyzes the graph and detects additional warnings
-func (m *Mapper) detectWarnings(graph *types.ResourceGraph, runtime *types.RuntimeNode) []types.MappingWarning {
+func (m *Mapper) detectWarnings(graph *types.ResourceGraph, opts Options) []types.MappingWarning {
 	var warnings []types.MappingWarning
 
+	for _, runtime := range graph.Runtimes {
+		warnings = append(warnings, m.detectRuntimeWarnings(graph, runtime, opts.scoped())...)
+	}
+
+	// A running DataMigrate explains elevated cache and IO activity
+	for _, op := range graph.GetResourcesByKind("DataMigrate") {
+		if op.Status.Phase != types.PhaseExecuting {
+			continue
+		}
+		warnings = append(warnings, types.MappingWarning{
+			Level:      types.WarningLevelInfo,
+			Code:       types.WarningCodes.DataMigrateRunning,
+			Message:    fmt.Sprintf("DataMigrate %s is running", op.Name),
+			Resource:   op.Name,
+			Suggestion: "Expect elevated cache and IO activity until the migration completes",
+		})
+	}
+
+	if opts.CheckUFS {
+		warnings = append(warnings, detectUFSWarnings(graph.Dataset)...)
+	}
+
+	warnings = append(warnings, detectPVMismatches(graph)...)
+
+	// Operations the Dataset status reports, which may predate or outlive their CRs
+	for _, op := range graph.Dataset.Operations {
+		warnings = append(warnings, types.MappingWarning{
+			Level:      types.WarningLevelInfo,
+			Code:       types.WarningCodes.DataOperationInProgress,
+			Message:    fmt.Sprintf("%s %s is in progress on Dataset %s", op.Type, op.Name, graph.Dataset.Name),
+			Resource:   op.Name,
+			Suggestion: "Expect cache and IO activity until it completes; check its CR for progress",
+		})
+	}
+
+	for _, sts := range graph.GetResourcesByKind("StatefulSet") {
+		if warning, ok := detectScaling(sts); ok {
+			warnings = append(warnings, warning)
+		}
+	}
+
+	// Check for resources being deleted
+	for _, res := range graph.Resources {
+		for _, node := range append([]types.K8sResourceNode{res}, res.Children...) {
+			if node.DeletionTimestamp == "" {
+				continue
+			}
+			warnings = append(warnings, types.MappingWarning{
+				Level:      types.WarningLevelWarning,
+				Code:       types.WarningCodes.DeletionInProgress,
+				Message:    fmt.Sprintf("%s %s is being deleted (since %s)", node.Kind, node.Name, node.DeletionTimestamp),
+				Resource:   node.Name,
+				Suggestion: "If deletion does not finish, check the resource's finalizers",
+			})
+		}
+	}
+
+	// Check for pods left on an old StatefulSet revision after a rolling update
+	for _, sts := range graph.GetResourcesByKind("StatefulSet") {
+		updateRevision := sts.Details["updateRevision"]
+		if updateRevision == "" {
+			continue
+		}
+		for _, pod := range sts.Children {
+			revision := pod.Details["controllerRevision"]
+			if revision == "" || revision == updateRevision {
+				continue
+			}
+			warnings = append(warnings, types.MappingWarning{
+				Level:      types.WarningLevelWarning,
+				Code:       types.WarningCodes.PodStaleRevision,
+				Message:    fmt.Sprintf("Pod %s is on revision %s, StatefulSet %s expects %s", pod.Name, revision, sts.Name, updateRevision),
+				Resource:   pod.Name,
+				Suggestion: "Check the StatefulSet rollout; delete the pod to force an update if it uses the OnDelete strategy",
+			})
+		}
+	}
+
+	// Check for orphaned resources: labeled as part of a Fluid release but without an owner
+	for _, res := range graph.Resources {
+		for _, node := range append([]types.K8sResourceNode{res}, res.Children...) {
+			if node.Owner != nil || !isFluidManaged(node.Labels) {
+				continue
+			}
+			warnings = append(warnings, types.MappingWarning{
+				Level:      types.WarningLevelWarning,
+				Code:       types.WarningCodes.OrphanedResource,
+				Message:    fmt.Sprintf("%s %s has Fluid labels but no owner reference", node.Kind, node.Name),
+				Resource:   node.Name,
+				Suggestion: "Check whether the Runtime was deleted or its controller failed to clean up",
+			})
+		}
+	}
+
+	// A Service without ready endpoints in front of ready pods usually has a wrong selector
+	for _, svc := range graph.GetResourcesByKind("Service") {
+		if warning, ok := detectServiceWithoutEndpoints(graph, svc); ok {
+			warnings = append(warnings, warning)
+		}
+	}
+
+	// Check for unhealthy resources. Services are covered by the endpoint check
+	// above and the runtime controller by CONTROLLER_UNAVAILABLE.
+	for _, res := range graph.Resources {
+		if res.Kind == "Service" || res.Component == types.ComponentController {
+			continue
+		}
+		if res.Status.Phase == types.PhaseNotReady || res.Status.Phase == types.PhaseFailed {
+			warnings = append(warnings, types.MappingWarning{
+				Level:    types.WarningLevelWarning,
+				Code:     types.WarningCodes.PodsNotReady,
+				Message:  fmt.Sprintf("%s %s is not ready (%s)", res.Kind, res.Name, res.Status.Ready),
+				Resource: res.Name,
+			})
+		}
+	}
+
+	return warnings
+}
+
+// detectRuntimeWarnings detects missing or unhealthy components of a single runtime
+func (m *Mapper) detectRuntimeWarnings(graph *types.ResourceGraph, runtime *types.RuntimeNode, scoped bool) []types.MappingWarning {
+	var warnings []types.MappingWarning
+	resources := graph.GetResourcesByRuntime(runtime.Name)
+
+	// A narrower selector legitimately leaves components out of the graph
+	if !scoped {
+		warnings = append(warnings, detectMissingComponents(resources, runtime)...)
+		if warning, ok := detectPartialCreation(resources, runtime); ok {
+			warnings = append(warnings, warning)
+		}
+		warnings = append(warnings, detectReplicaDrift(resources, runtime)...)
+	}
+
+	// Check runtime-reported component phases
+	components := []struct {
+		name  string
+		phase string
+		ready string
+	}{
+		{"Master", runtime.MasterPhase, runtime.MasterReady},
+		{"Worker", runtime.WorkerPhase, runtime.WorkerReady},
+		{"Fuse", runtime.FusePhase, runtime.FuseReady},
+	}
+	for _, c := range components {
+		level := types.WarningLevelWarning
+		switch c.phase {
+		case "PartialReady":
+		case string(types.PhaseFailed):
+			level = types.WarningLevelError
+		default:
+			continue
+		}
+		warnings = append(warnings, types.MappingWarning{
+			Level:      level,
+			Code:       types.WarningCodes.ComponentNotReady,
+			Message:    fmt.Sprintf("Runtime reports %s phase %s (%s)", c.name, c.phase, c.ready),
+			Resource:   runtime.Name,
+			Suggestion: fmt.Sprintf("Inspect the %s pods of runtime %s", strings.ToLower(c.name), runtime.Name),
+		})
+	}
+
+	return warnings
+}
+
+// detectServiceWithoutEndpoints reports a Service with no ready endpoints while a
+// StatefulSet of the same component has ready pods
+func detectServiceWithoutEndpoints(graph *types.ResourceGraph, svc types.K8sResourceNode) (types.MappingWarning, bool) {
+	if ready, _, ok := types.ParseReady(svc.Status.Ready); !ok || ready > 0 {
+		return types.MappingWarning{}, false
+	}
+
+	for _, sts := range graph.GetResourcesByKind("StatefulSet") {
+		if sts.Component != svc.Component || sts.Runtime != svc.Runtime {
+			continue
+		}
+		if ready, _, ok := types.ParseReady(sts.Status.Ready); ok && ready > 0 {
+			return types.MappingWarning{
+				Level:      types.WarningLevelWarning,
+				Code:       types.WarningCodes.ServiceNoEndpoints,
+				Message:    fmt.Sprintf("Service %s has no ready endpoints although StatefulSet %s has %d ready pods", svc.Name, sts.Name, ready),
+				Resource:   svc.Name,
+				Suggestion: "Compare the Service selector with the pod labels",
+			}, true
+		}
+	}
+	return types.MappingWarning{}, false
+}
+
+// detectReplicaDrift compares the worker count in the Runtime spec with the
+// replicas of its worker StatefulSets. The controller copies the former into
+// the latter, so a lasting difference means it is not reconciling.
+func detectReplicaDrift(resources []types.K8sResourceNode, runtime *types.RuntimeNode) []types.MappingWarning {
+	if runtime.WorkerReplicas <= 0 {
+		return nil
+	}
+
+	var warnings []types.MappingWarning
+	for _, sts := range filterByComponent(resources, types.ComponentWorker) {
+		if sts.Kind != ResourceKinds.StatefulSet {
+			continue
+		}
+		replicas, err := strconv.ParseInt(sts.Details["desiredReplicas"], 10, 64)
+		if err != nil || replicas == runtime.WorkerReplicas {
+			continue
+		}
+		warnings = append(warnings, types.MappingWarning{
+			Level:      types.WarningLevelWarning,
+			Code:       types.WarningCodes.ReplicaDrift,
+			Message:    fmt.Sprintf("Runtime %s asks for %d workers but StatefulSet %s has %d replicas", runtime.Name, runtime.WorkerReplicas, sts.Name, replicas),
+			Resource:   sts.Name,
+			Suggestion: "Check that the runtime controller is running and reconciling; its logs should show why the StatefulSet was not updated",
+		})
+	}
+	return warnings
+}
+
+// detectScaling reports a StatefulSet whose replica count or revision is still converging
+func detectScaling(sts types.K8sResourceNode) (types.MappingWarning, bool) {
+	desired, err1 := strconv.Atoi(sts.Details["desiredReplicas"])
+	current, err2 := strconv.Atoi(sts.Details["currentReplicas"])
+	updated, err3 := strconv.Atoi(sts.Details["updatedReplicas"])
+	if err1 != nil || err2 != nil || err3 != nil {
+		return types.MappingWarning{}, false
+	}
+
+	var message string
+	switch {
+	case current != desired:
+		message = fmt.Sprintf("StatefulSet %s is scaling from %d to %d replicas", sts.Name, current, desired)
+	case sts.Status.Phase == types.PhaseUpdating:
+		message = fmt.Sprintf("StatefulSet %s is rolling out a new revision (%d/%d updated)", sts.Name, updated, desired)
+	default:
+		return types.MappingWarning{}, false
+	}
+
+	return types.MappingWarning{
+		Level:      types.WarningLevelInfo,
+		Code:       types.WarningCodes.ScalingInProgress,
+		Message:    message,
+		Resource:   sts.Name,
+		Suggestion: "Not-ready pods are expected until the StatefulSet converges",
+	}, true
+}
+
+// componentOrder ranks components for sortResources in rendering order
+var componentOrder = map[types.ComponentType]int{
+	types.ComponentMaster:     0,
+	types.ComponentWorker:     1,
+	types.ComponentFuse:       2,
+	types.ComponentStorage:    3,
+	types.ComponentConfig:     4,
+	types.ComponentOperation:  5,
+	types.ComponentController: 6,
+}
+
+// sortResources orders resources by component, kind, name and namespace, and
+// each node's children by name, recursively
+func sortResources(resources []types.K8sResourceNode) {
+	sort.SliceStable(resources, func(i, j int) bool {
+		a, b := resources[i], resources[j]
+		if a.Component != b.Component {
+			ra, okA := componentOrder[a.Component]
+			rb, okB := componentOrder[b.Component]
+			if okA != okB {
+				return okA
+			}
+			if ra != rb {
+				return ra < rb
+			}
+			return a.Component < b.Component
+		}
+		if a.Kind != b.Kind {
+			return a.Kind < b.Kind
+		}
+		if a.Name != b.Name {
+			return a.Name < b.Name
+		}
+		return a.Namespace < b.Namespace
+	})
+
+	for i := range resources {
+		sortChildren(resources[i].Children)
+	}
+}
+
+// sortChildren orders child nodes by name, recursively
+func sortChildren(children []types.K8sResourceNode) {
+	sort.SliceStable(children, func(i, j int) bool {
+		return children[i].Name < children[j].Name
+	})
+	for i := range children {
+		sortChildren(children[i].Children)
+	}
+}
+
+// ownerInfo returns the first owner reference, or nil if there is none
+func ownerInfo(refs []metav1.OwnerReference) *types.OwnerInfo {
+	if len(refs) == 0 {
+		return nil
+	}
+	return &types.OwnerInfo{
+		Kind: refs[0].Kind,
+		Name: refs[0].Name,
+		UID:  string(refs[0].UID),
+	}
+}
+
+// deletionTimestamp formats an object's deletion timestamp, or "" if it is not being deleted
+func deletionTimestamp(t *metav1.Time) string {
+	if t == nil {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339)
+}
+
+// detectMissingComponents reports expected master, worker and fuse components without any resources
+func detectMissingComponents(resources []types.K8sResourceNode, runtime *types.RuntimeNode) []types.MappingWarning {
+	var warnings []types.MappingWarning
+
+	// Only components the runtime type actually deploys can be missing,
+	// e.g. ThinRuntime has neither master nor worker
+	expected := GetRuntimeComponents(runtime.Type)
+
 	// Check for missing master
-	masters := graph.GetResourcesByComponent(types.ComponentMaster)
-	if len(masters) == 0 && runtime != nil {
+	masters := filterByComponent(resources, types.ComponentMaster)
+	if expected.HasMaster && len(masters) == 0 {
 		warnings = append(warnings, types.MappingWarning{
 			Level:      types.WarningLevelError,
 			Code:       types.WarningCodes.MasterMissing,
 			Message:    "No Master StatefulSet found",
+			Resource:   runtime.Name,
 			Suggestion: "Check if the runtime controller is running correctly",
 		})
 	}
 
 	// Check for missing workers
-	workers := graph.GetResourcesByComponent(types.ComponentWorker)
-	if len(workers) == 0 && runtime != nil {
+	workers := filterByComponent(resources, types.ComponentWorker)
+	if expected.HasWorker && len(workers) == 0 {
 		warnings = append(warnings, types.MappingWarning{
 			Level:      types.WarningLevelError,
 			Code:       types.WarningCodes.WorkerMissing,
 			Message:    "No Worker StatefulSet found",
+			Resource:   runtime.Name,
 			Suggestion: "Check if the runtime controller is running correctly",
 		})
 	}
 
 	// Check for missing fuse
-	fuseResources := graph.GetResourcesByComponent(types.ComponentFuse)
-	if len(fuseResources) == 0 && runtime != nil {
+	// In sidecar mode the fuse lives in the application pods, not in a DaemonSet
+	fuseResources := filterByComponent(resources, types.ComponentFuse)
+	if expected.HasFuse && len(fuseResources) == 0 && runtime.FuseMode != types.FuseModeSidecar {
 		warnings = append(warnings, types.MappingWarning{
 			Level:      types.WarningLevelWarning,
 			Code:       types.WarningCodes.FuseMissing,
 			Message:    "No Fuse DaemonSet found",
+			Resource:   runtime.Name,
 			Suggestion: "Fuse pods are created on-demand when data is accessed",
 		})
 	}
 
-	// Check for unhealthy resources
-	for _, res := range graph.Resources {
-		if res.Status.Phase == types.PhaseNotReady || res.Status.Phase == types.PhaseFailed {
+	return warnings
+}
+
+// detectPartialCreation reports a runtime where some of the components expected for
+// its type exist while others are entirely missing, i.e. it is half deployed rather
+// than never deployed
+func detectPartialCreation(resources []types.K8sResourceNode, runtime *types.RuntimeNode) (types.MappingWarning, bool) {
+	expected := GetRuntimeComponents(runtime.Type)
+	components := []struct {
+		name      string
+		component types.ComponentType
+		expected  bool
+	}{
+		{"master", types.ComponentMaster, expected.HasMaster},
+		{"worker", types.ComponentWorker, expected.HasWorker},
+		{"fuse", types.ComponentFuse, expected.HasFuse},
+	}
+
+	var present, missing []string
+	for _, c := range components {
+		if !c.expected {
+			continue
+		}
+		if len(filterByComponent(resources, c.component)) > 0 || (c.component == types.ComponentFuse && runtime.FuseMode == types.FuseModeSidecar) {
+			present = append(present, c.name)
+		} else {
+			missing = append(missing, c.name)
+		}
+	}
+	if len(present) == 0 || len(missing) == 0 {
+		return types.MappingWarning{}, false
+	}
+
+	return types.MappingWarning{
+		Level:      types.WarningLevelWarning,
+		Code:       types.WarningCodes.PartialCreation,
+		Message:    fmt.Sprintf("Runtime %s is partially created: %s present, %s missing", runtime.Name, strings.Join(present, ", "), strings.Join(missing, ", ")),
+		Resource:   runtime.Name,
+		Suggestion: "Check the runtime controller logs and events for errors while creating the missing components",
+	}, true
+}
+
+// Helper functions
+
+// interrupted returns a MappingIncomplete warning when the context was cancelled
+// or its deadline passed before the given step could run
+func interrupted(ctx context.Context, step string) (types.MappingWarning, bool) {
+	err := ctx.Err()
+	if err == nil {
+		return types.MappingWarning{}, false
+	}
+	return types.MappingWarning{
+		Level:      types.WarningLevelError,
+		Code:       types.WarningCodes.MappingIncomplete,
+		Message:    fmt.Sprintf("Mapping stopped before %s: %v", step, err),
+		Suggestion: "The graph is partial; check API server health or increase the timeout",
+	}, true
+}
+
+// filterByComponent returns the resources belonging to a component type
+func filterByComponent(resources []types.K8sResourceNode, component types.ComponentType) []types.K8sResourceNode {
+	var result []types.K8sResourceNode
+	for _, r := range resources {
+		if r.Component == component {
+			result = append(result, r)
+		}
+	}
+	return result
+}
+
+// detectPVMismatches checks each PersistentVolume reached through a Dataset
+// PVC against its claimRef. A PV bound to another claim, e.g. after a botched
+// Dataset rename, or claimed by several of the Dataset's PVCs is a mismatch;
+// a PV without a claimRef is not bound.
+func detectPVMismatches(graph *types.ResourceGraph) []types.MappingWarning {
+	pvcNamespaces := make(map[string]string)
+	for _, pvc := range graph.GetResourcesByKind("PersistentVolumeClaim") {
+		pvcNamespaces[pvc.Name] = pvc.Namespace
+	}
+
+	var warnings []types.MappingWarning
+	for _, pv := range graph.GetResourcesByKind("PersistentVolume") {
+		claims := strings.Split(pv.Details["claims"], ",")
+		if len(claims) > 1 {
 			warnings = append(warnings, types.MappingWarning{
-				Level:    types.WarningLevelWarning,
-				Code:     types.WarningCodes.PodsNotReady,
-				Message:  fmt.Sprintf("%s %s is not ready (%s)", res.Kind, res.Name, res.Status.Ready),
-				Resource: res.Name,
+				Level:      types.WarningLevelError,
+				Code:       types.WarningCodes.PVMismatch,
+				Message:    fmt.Sprintf("PersistentVolume %s is claimed by several PVCs: %s", pv.Name, strings.Join(claims, ", ")),
+				Resource:   pv.Name,
+				Suggestion: "Each Dataset needs its own PV; check which Dataset the extra PVCs belong to",
 			})
+			continue
+		}
+
+		claimRef, ok := pv.Details["claimRef"]
+		if !ok {
+			warnings = append(warnings, types.MappingWarning{
+				Level:      types.WarningLevelWarning,
+				Code:       types.WarningCodes.PVNotBound,
+				Message:    fmt.Sprintf("PersistentVolume %s has no claimRef, so PVC %s is not bound to it", pv.Name, claims[0]),
+				Resource:   pv.Name,
+				Suggestion: "Check the PVC's events for binding errors",
+			})
+			continue
 		}
+
+		refNamespace, refName, found := strings.Cut(claimRef, "/")
+		if !found {
+			refNamespace, refName = "", claimRef
+		}
+		if refName == claims[0] && (refNamespace == "" || refNamespace == pvcNamespaces[claims[0]]) {
+			continue
+		}
+		warnings = append(warnings, types.MappingWarning{
+			Level:      types.WarningLevelError,
+			Code:       types.WarningCodes.PVMismatch,
+			Message:    fmt.Sprintf("PersistentVolume %s is bound to claim %s, not to the Dataset's PVC %s/%s", pv.Name, claimRef, pvcNamespaces[claims[0]], claims[0]),
+			Resource:   pv.Name,
+			Suggestion: "Check the PV's claimRef; another Dataset, e.g. one left over from a rename, may be using its volume",
+		})
 	}
+	return warnings
+}
 
+// detectUFSWarnings reports the Dataset's failing mount- or UFS-related
+// conditions. A Dataset can be Bound while its UFS is unreachable, e.g. with
+// a wrong bucket or expired credentials, so the phase alone does not show it.
+func detectUFSWarnings(dataset types.DatasetNode) []types.MappingWarning {
+	var warnings []types.MappingWarning
+	for _, c := range dataset.Conditions {
+		reason := strings.ToLower(c.Reason)
+		if !strings.Contains(reason, "mount") && !strings.Contains(reason, "ufs") {
+			continue
+		}
+		if !conditionFailing(c) {
+			continue
+		}
+
+		mountPoint := failingMountPoint(dataset, c.Message)
+		resource := mountPoint
+		if resource == "" {
+			resource = dataset.Name
+			mountPoint = strings.Join(dataset.MountPoints, ", ")
+		}
+		warnings = append(warnings, types.MappingWarning{
+			Level:      types.WarningLevelError,
+			Code:       types.WarningCodes.UFSUnreachable,
+			Message:    fmt.Sprintf("UFS mount %s of Dataset %s is failing: %s: %s", mountPoint, dataset.Name, c.Reason, c.Message),
+			Resource:   resource,
+			Suggestion: "Check the mount point URI, its credentials and network access from the runtime pods",
+		})
+	}
 	return warnings
 }
 
-// Helper functions
+// conditionFailing reports whether a condition signals a failure: a negative
+// type (e.g. Failed, NotReady) that is True, or any other type that is False
+func conditionFailing(c types.ConditionBrief) bool {
+	conditionType := strings.ToLower(c.Type)
+	if strings.Contains(conditionType, "fail") || strings.Contains(conditionType, "notready") || strings.Contains(conditionType, "error") {
+		return c.Status == "True"
+	}
+	return c.Status == "False"
+}
 
+// failingMountPoint returns the mount point a condition message names, by URI
+// or mount name, or the only mount point when the Dataset has one
+func failingMountPoint(dataset types.DatasetNode, message string) string {
+	for _, mount := range dataset.Mounts {
+		if strings.Contains(message, mount.MountPoint) || (mount.Name != "" && strings.Contains(message, mount.Name)) {
+			return mount.MountPoint
+		}
+	}
+	if len(dataset.MountPoints) == 1 {
+		return dataset.MountPoints[0]
+	}
+	return ""
+}
+
+// determineComponent resolves the component from the role label. Known roles
+// from ComponentRoles are matched exactly; other roles fall back to a
+// "-master"/"-worker"/"-fuse" suffix match.
 func determineComponent(labels map[string]string) types.ComponentType {
-	role := labels["role"]
-	switch {
-	case contains(role, "master"):
-		return types.ComponentMaster
-	case contains(role, "worker"):
-		return types.ComponentWorker
-	case contains(role, "fuse"):
-		return types.ComponentFuse
-	default:
+	role := labels[FluidLabels.Role]
+	if role == "" {
 		return types.ComponentType("")
 	}
+
+	for component, roles := range ComponentRoles {
+		for _, r := range roles {
+			if role == r {
+				return component
+			}
+		}
+	}
+
+	for _, component := range []types.ComponentType{types.ComponentMaster, types.ComponentWorker, types.ComponentFuse} {
+		if strings.HasSuffix(role, "-"+string(component)) {
+			return component
+		}
+	}
+	return types.ComponentType("")
 }
 
-func contains(s, substr string) bool {
-	return len(s) >= len(substr) && (s == substr || len(s) > len(substr) && s[len(s)-len(substr):] == substr || s[:len(substr)] == substr || findSubstring(s, substr))
+// normalizeWarnings drops warnings repeating an earlier one's code, resource
+// and message, and sorts the rest by level (error first), then code. The sort
+// is stable, so warnings with the same level and code keep discovery order.
+func normalizeWarnings(warnings []types.MappingWarning) []types.MappingWarning {
+	seen := make(map[[3]string]bool, len(warnings))
+	unique := warnings[:0]
+	for _, w := range warnings {
+		key := [3]string{w.Code, w.Resource, w.Message}
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		unique = append(unique, w)
+	}
+
+	sort.SliceStable(unique, func(i, j int) bool {
+		if si, sj := unique[i].Level.Severity(), unique[j].Level.Severity(); si != sj {
+			return si > sj
+		}
+		return unique[i].Code < unique[j].Code
+	})
+	return unique
 }
 
-func findSubstring(s, substr string) bool {
-	for i := 0; i <= len(s)-len(substr); i++ {
-		if s[i:i+len(substr)] == substr {
+// hasWarning reports whether a warning with the given code is present
+func hasWarning(warnings []types.MappingWarning, code string) bool {
+	for _, w := range warnings {
+		if w.Code == code {
 			return true
 		}
 	}
 	return false
 }
 
+// isFluidManaged reports whether the labels mark a resource as part of a Fluid release
+func isFluidManaged(labels map[string]string) bool {
+	return labels[FluidLabels.Release] != "" || labels[FluidLabels.Dataset] != ""
+}
+
 func filterLabels(labels map[string]string) map[string]string {
 	filtered := make(map[string]string)
 	for k, v := range labels {
 		// Only include relevant labels
 		switch k {
-		case "release", "app", "role", "component":
+		case FluidLabels.Release, FluidLabels.App, FluidLabels.Role, FluidLabels.Component, FluidLabels.Dataset:
 			filtered[k] = v
 		}
 	}
@@ -0,0 +1,90 @@
+package mapper
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	appsv1 "k8s.io/api/apps/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/fluid-cloudnative/fluid-resource-mapper/pkg/k8s"
+	"github.com/fluid-cloudnative/fluid-resource-mapper/pkg/types"
+)
+
+func TestDetermineComponent(t *testing.T) {
+	tests := []struct {
+		name   string
+		labels map[string]string
+		want   types.ComponentType
+	}{
+		{"exact master role", map[string]string{"role": "alluxio-master"}, types.ComponentMaster},
+		{"exact worker role", map[string]string{"role": "jindo-worker"}, types.ComponentWorker},
+		{"exact fuse role", map[string]string{"role": "thin-fuse"}, types.ComponentFuse},
+		{"suffix-only master", map[string]string{"role": "mycache-master"}, types.ComponentMaster},
+		{"suffix-only worker", map[string]string{"role": "mycache-worker"}, types.ComponentWorker},
+		{"suffix-only fuse", map[string]string{"role": "mycache-fuse"}, types.ComponentFuse},
+		{"component as prefix", map[string]string{"role": "masterful-worker"}, types.ComponentWorker},
+		{"component without dash", map[string]string{"role": "mycacheworker"}, ""},
+		{"unrelated role", map[string]string{"role": "training"}, ""},
+		{"empty role", map[string]string{"role": ""}, ""},
+		{"no role label", map[string]string{"app": "alluxio"}, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := determineComponent(tt.labels); got != tt.want {
+				t.Errorf("determineComponent(%v) = %q, want %q", tt.labels, got, tt.want)
+			}
+		})
+	}
+}
+
+// workloadListFailingClient fails every StatefulSet and DaemonSet list
+type workloadListFailingClient struct {
+	k8s.Client
+	err error
+}
+
+func (c workloadListFailingClient) ListStatefulSets(context.Context, string, string) (*appsv1.StatefulSetList, error) {
+	return nil, c.err
+}
+
+func (c workloadListFailingClient) ListDaemonSets(context.Context, string, string) (*appsv1.DaemonSetList, error) {
+	return nil, c.err
+}
+
+func TestResolveLabelSelector(t *testing.T) {
+	statefulSets := schema.GroupResource{Group: "apps", Resource: "statefulsets"}
+	tests := []struct {
+		name   string
+		client k8s.Client
+		want   string
+	}{
+		{"release label only", k8s.NewMockClient(k8s.ScenarioHealthy), "release=demo-data"},
+		{"dataset label", k8s.NewMockClient(k8s.ScenarioDatasetLabel), "fluid.io/dataset=default-demo-data"},
+		{
+			"forbidden probe keeps the dataset label",
+			workloadListFailingClient{k8s.NewMockClient(k8s.ScenarioHealthy), apierrors.NewForbidden(statefulSets, "", errors.New("RBAC: access denied"))},
+			"fluid.io/dataset=default-demo-data",
+		},
+		{
+			"timed out probe keeps the dataset label",
+			workloadListFailingClient{k8s.NewMockClient(k8s.ScenarioHealthy), apierrors.NewTimeoutError("request timed out", 5)},
+			"fluid.io/dataset=default-demo-data",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			graph, err := New(tt.client).MapFromDataset(context.Background(), "demo-data", "default", DefaultOptions())
+			if graph == nil {
+				t.Fatalf("mapping failed: %v", err)
+			}
+			if got := graph.Metadata.LabelSelector; got != tt.want {
+				t.Errorf("LabelSelector = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
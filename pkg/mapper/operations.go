@@ -0,0 +1,78 @@
+// Package mapper data operation discovery
+package mapper
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/fluid-cloudnative/fluid-resource-mapper/pkg/types"
+)
+
+// discoverDataOperations discovers data operation CRs targeting the Dataset
+func (m *Mapper) discoverDataOperations(ctx context.Context, dataset types.DatasetNode, opts Options) ([]types.K8sResourceNode, []types.MappingWarning) {
+	var resources []types.K8sResourceNode
+	var warnings []types.MappingWarning
+
+	operations := []struct {
+		kind string
+		list func(ctx context.Context, datasetName, namespace string) (*unstructured.UnstructuredList, error)
+	}{
+		{ResourceKinds.DataLoad, m.client.GetDataLoads},
+		{ResourceKinds.DataBackup, m.client.GetDataBackups},
+		{ResourceKinds.DataMigrate, m.client.GetDataMigrates},
+	}
+
+	for _, op := range operations {
+		if !opts.kindEnabled(op.kind) {
+			continue
+		}
+		list, err := op.list(ctx, dataset.Name, dataset.Namespace)
+		if err != nil {
+			warnings = append(warnings, types.MappingWarning{
+				Level:   types.WarningLevelInfo,
+				Code:    strings.ToUpper(op.kind) + "_LIST_FAILED",
+				Message: fmt.Sprintf("Failed to list %ss: %v", op.kind, err),
+			})
+			continue
+		}
+
+		m.logger.Debug("discovered data operations", "kind", op.kind, "dataset", dataset.Name, "namespace", dataset.Namespace, "count", len(list.Items))
+		for i := range list.Items {
+			resources = append(resources, parseDataOperation(&list.Items[i]))
+		}
+	}
+
+	return resources, warnings
+}
+
+// parseDataOperation converts an unstructured data operation CR to a resource node
+func parseDataOperation(obj *unstructured.Unstructured) types.K8sResourceNode {
+	node := types.K8sResourceNode{
+		Kind:       obj.GetKind(),
+		APIVersion: obj.GetAPIVersion(),
+		Name:       obj.GetName(),
+		Namespace:  obj.GetNamespace(),
+		Component:  types.ComponentOperation,
+		Status: types.ResourceStatus{
+			Phase:     types.PhasePending,
+			Age:       formatAge(obj.GetCreationTimestamp().Time),
+			CreatedAt: obj.GetCreationTimestamp().Time,
+		},
+		DeletionTimestamp: deletionTimestamp(obj.GetDeletionTimestamp()),
+		Terminating:       obj.GetDeletionTimestamp() != nil,
+	}
+
+	if phase, _, _ := unstructured.NestedString(obj.Object, "status", "phase"); phase != "" {
+		node.Status.Phase = types.ResourcePhase(phase)
+	}
+	if duration, _, _ := unstructured.NestedString(obj.Object, "status", "duration"); duration != "" {
+		node.Details = map[string]string{
+			"duration": duration,
+		}
+	}
+
+	return node
+}
@@ -0,0 +1,76 @@
+// Package mapper owner chain resolution
+package mapper
+
+import (
+	"github.com/fluid-cloudnative/fluid-resource-mapper/pkg/types"
+)
+
+// resolveOwnerChains links each owner in the graph to its own owner, e.g.
+// Pod → StatefulSet → AlluxioRuntime → Dataset. Only objects already in the
+// graph are consulted, so chains stop at the first owner that was not mapped.
+func resolveOwnerChains(graph *types.ResourceGraph) {
+	// Direct owner of every mapped object, keyed by kind/namespace/name
+	owners := make(map[string]*types.OwnerInfo)
+
+	var index func(nodes []types.K8sResourceNode)
+	index = func(nodes []types.K8sResourceNode) {
+		for _, node := range nodes {
+			if node.Owner != nil {
+				owners[ownerKey(node.Kind, node.Namespace, node.Name)] = node.Owner
+			}
+			index(node.Children)
+		}
+	}
+	index(graph.Resources)
+	for _, runtime := range graph.Runtimes {
+		if runtime.Owner != nil {
+			owners[ownerKey(runtime.Kind, runtime.Namespace, runtime.Name)] = runtime.Owner
+		}
+	}
+	if graph.Dataset.Owner != nil {
+		owners[ownerKey("Dataset", graph.Dataset.Namespace, graph.Dataset.Name)] = graph.Dataset.Owner
+	}
+
+	var link func(nodes []types.K8sResourceNode)
+	link = func(nodes []types.K8sResourceNode) {
+		for i := range nodes {
+			if nodes[i].Owner != nil {
+				nodes[i].Owner = ownerChain(owners, nodes[i].Owner, nodes[i].Namespace, map[string]bool{
+					ownerKey(nodes[i].Kind, nodes[i].Namespace, nodes[i].Name): true,
+				})
+			}
+			link(nodes[i].Children)
+		}
+	}
+	link(graph.Resources)
+	for _, runtime := range graph.Runtimes {
+		if runtime.Owner != nil {
+			runtime.Owner = ownerChain(owners, runtime.Owner, runtime.Namespace, map[string]bool{
+				ownerKey(runtime.Kind, runtime.Namespace, runtime.Name): true,
+			})
+		}
+	}
+}
+
+// ownerChain returns a copy of owner with Parent filled in recursively.
+// Owner references are namespace-local, so the whole chain shares namespace.
+// visited guards against owner cycles, which the API server does not prevent.
+func ownerChain(owners map[string]*types.OwnerInfo, owner *types.OwnerInfo, namespace string, visited map[string]bool) *types.OwnerInfo {
+	resolved := *owner
+	resolved.Parent = nil
+
+	key := ownerKey(owner.Kind, namespace, owner.Name)
+	if visited[key] {
+		return &resolved
+	}
+	visited[key] = true
+
+	if parent, ok := owners[key]; ok {
+		resolved.Parent = ownerChain(owners, parent, namespace, visited)
+	}
+	return &resolved
+}
+
+func ownerKey(kind, namespace, name string) string {
+	return kind + "/" + namespace + "/" + name
+}
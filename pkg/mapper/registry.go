@@ -0,0 +1,114 @@
+// Package mapper registry of custom runtime types
+package mapper
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/yaml"
+
+	"github.com/fluid-cloudnative/fluid-resource-mapper/pkg/k8s"
+	"github.com/fluid-cloudnative/fluid-resource-mapper/pkg/types"
+)
+
+// registeredComponents holds the components of runtime types added with
+// RegisterRuntimeType, consulted before the built-in types
+var registeredComponents = make(map[types.RuntimeType]RuntimeComponents)
+
+// RegisterRuntimeType adds a runtime type, e.g. an in-house runtime, to the
+// registries used during mapping: k8s.RuntimeTypeToGVR to fetch its CR,
+// GetRuntimeComponents to know which components to expect, and ComponentRoles
+// to classify its resources by role label. Registering a built-in type
+// overrides its GVR and components. Call it before mapping starts; the
+// registries are not safe for concurrent modification.
+func RegisterRuntimeType(name string, gvr schema.GroupVersionResource, components RuntimeComponents, roles map[types.ComponentType][]string) {
+	k8s.RuntimeTypeToGVR[name] = gvr
+	registeredComponents[types.RuntimeType(name)] = components
+	for component, componentRoles := range roles {
+		for _, role := range componentRoles {
+			if !hasRole(ComponentRoles[component], role) {
+				ComponentRoles[component] = append(ComponentRoles[component], role)
+			}
+		}
+	}
+}
+
+// hasRole reports whether roles contains role
+func hasRole(roles []string, role string) bool {
+	for _, r := range roles {
+		if r == role {
+			return true
+		}
+	}
+	return false
+}
+
+// RuntimeConfig is the file format read by LoadRuntimeConfig
+type RuntimeConfig struct {
+	Runtimes []RuntimeDefinition `json:"runtimes"`
+}
+
+// RuntimeDefinition describes a runtime type to register, e.g.
+//
+//	name: mycache
+//	group: data.example.com
+//	version: v1alpha1
+//	resource: mycacheruntimes
+//	components: {master: true, worker: true, fuse: true}
+//	roles:
+//	  master: [mycache-master]
+//	  worker: [mycache-worker]
+//	  fuse: [mycache-fuse]
+type RuntimeDefinition struct {
+	Name       string                           `json:"name"`
+	Group      string                           `json:"group"`
+	Version    string                           `json:"version"`
+	Resource   string                           `json:"resource"`
+	Components RuntimeComponents                `json:"components"`
+	Roles      map[types.ComponentType][]string `json:"roles,omitempty"`
+}
+
+// validate reports the first required field the definition is missing
+func (d RuntimeDefinition) validate() error {
+	switch {
+	case d.Name == "":
+		return errors.New("runtime definition without a name")
+	case d.Version == "" || d.Resource == "":
+		return fmt.Errorf("runtime %s: version and resource are required", d.Name)
+	}
+	for component := range d.Roles {
+		switch component {
+		case types.ComponentMaster, types.ComponentWorker, types.ComponentFuse:
+		default:
+			return fmt.Errorf("runtime %s: unknown component %q in roles, expected master, worker or fuse", d.Name, component)
+		}
+	}
+	return nil
+}
+
+// LoadRuntimeConfig registers every runtime defined in a YAML or JSON file.
+// Nothing is registered if any definition is invalid.
+func LoadRuntimeConfig(path string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("failed to read runtime config: %w", err)
+	}
+
+	var config RuntimeConfig
+	if err := yaml.UnmarshalStrict(data, &config); err != nil {
+		return fmt.Errorf("failed to parse runtime config %s: %w", path, err)
+	}
+	for _, d := range config.Runtimes {
+		if err := d.validate(); err != nil {
+			return fmt.Errorf("invalid runtime config %s: %w", path, err)
+		}
+	}
+
+	for _, d := range config.Runtimes {
+		gvr := schema.GroupVersionResource{Group: d.Group, Version: d.Version, Resource: d.Resource}
+		RegisterRuntimeType(d.Name, gvr, d.Components, d.Roles)
+	}
+	return nil
+}
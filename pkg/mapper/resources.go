@@ -2,15 +2,21 @@
 package mapper
 
 import (
+	"path"
+
 	"github.com/fluid-cloudnative/fluid-resource-mapper/pkg/types"
 )
 
 // LabelSelectors contains standard Fluid label selectors
 var LabelSelectors = struct {
+	Dataset     func(namespace, name string) string
 	Release     func(name string) string
 	RuntimeType func(runtimeType string) string
 	Role        func(role string) string
 }{
+	Dataset: func(namespace, name string) string {
+		return "fluid.io/dataset=" + namespace + "-" + name
+	},
 	Release: func(name string) string {
 		return "release=" + name
 	},
@@ -33,6 +39,7 @@ var NamingConventions = struct {
 	MasterConfig      func(name string) string
 	WorkerConfig      func(name string) string
 	FuseConfig        func(name string) string
+	RuntimeController func(runtimeType string) string
 }{
 	MasterStatefulSet: func(name string) string { return name + "-master" },
 	WorkerStatefulSet: func(name string) string { return name + "-worker" },
@@ -43,27 +50,47 @@ var NamingConventions = struct {
 	MasterConfig:      func(name string) string { return name + "-master-config" },
 	WorkerConfig:      func(name string) string { return name + "-worker-config" },
 	FuseConfig:        func(name string) string { return name + "-fuse-config" },
+	RuntimeController: func(runtimeType string) string { return runtimeType + "runtime-controller" },
 }
 
 // ResourceKinds defines the Kubernetes resource kinds we discover
 var ResourceKinds = struct {
-	StatefulSet           string
-	DaemonSet             string
-	Pod                   string
-	PersistentVolumeClaim string
-	PersistentVolume      string
-	ConfigMap             string
-	Secret                string
-	Service               string
+	StatefulSet             string
+	DaemonSet               string
+	Pod                     string
+	PersistentVolumeClaim   string
+	PersistentVolume        string
+	StorageClass            string
+	ConfigMap               string
+	Secret                  string
+	Service                 string
+	HorizontalPodAutoscaler string
+	DataLoad                string
+	DataBackup              string
+	DataMigrate             string
 }{
-	StatefulSet:           "StatefulSet",
-	DaemonSet:             "DaemonSet",
-	Pod:                   "Pod",
-	PersistentVolumeClaim: "PersistentVolumeClaim",
-	PersistentVolume:      "PersistentVolume",
-	ConfigMap:             "ConfigMap",
-	Secret:                "Secret",
-	Service:               "Service",
+	StatefulSet:             "StatefulSet",
+	DaemonSet:               "DaemonSet",
+	Pod:                     "Pod",
+	PersistentVolumeClaim:   "PersistentVolumeClaim",
+	PersistentVolume:        "PersistentVolume",
+	StorageClass:            "StorageClass",
+	ConfigMap:               "ConfigMap",
+	Secret:                  "Secret",
+	Service:                 "Service",
+	HorizontalPodAutoscaler: "HorizontalPodAutoscaler",
+	DataLoad:                "DataLoad",
+	DataBackup:              "DataBackup",
+	DataMigrate:             "DataMigrate",
+}
+
+// allResourceKinds returns every ResourceKinds value, for validating kind filters
+func allResourceKinds() []string {
+	k := ResourceKinds
+	return []string{
+		k.StatefulSet, k.DaemonSet, k.Pod, k.PersistentVolumeClaim, k.PersistentVolume, k.StorageClass,
+		k.ConfigMap, k.Secret, k.Service, k.HorizontalPodAutoscaler, k.DataLoad, k.DataBackup, k.DataMigrate,
+	}
 }
 
 // FluidLabels defines standard Fluid labels
@@ -89,5 +116,29 @@ var FluidLabels = struct {
 var ComponentRoles = map[types.ComponentType][]string{
 	types.ComponentMaster: {"alluxio-master", "jindo-master", "juicefs-master", "goosefs-master", "vineyard-master", "efc-master"},
 	types.ComponentWorker: {"alluxio-worker", "jindo-worker", "juicefs-worker", "goosefs-worker", "vineyard-worker", "efc-worker"},
-	types.ComponentFuse:   {"alluxio-fuse", "jindo-fuse", "juicefs-fuse", "goosefs-fuse", "vineyard-fuse", "efc-fuse"},
+	types.ComponentFuse:   {"alluxio-fuse", "jindo-fuse", "juicefs-fuse", "goosefs-fuse", "vineyard-fuse", "efc-fuse", "thin-fuse"},
+}
+
+// DefaultConfigDenylist lists ConfigMaps and Secrets that match a release's
+// labels but are not Fluid configuration: the CA bundles Kubernetes and
+// OpenShift inject into every namespace, and service account tokens.
+// Entries are path.Match patterns tested against the object's name and,
+// for Secrets, its type.
+var DefaultConfigDenylist = []string{
+	"kube-root-ca.crt",
+	"openshift-service-ca.crt",
+	"*-token-?????",
+	"kubernetes.io/service-account-token",
+}
+
+// denied reports whether any of values matches a denylist pattern
+func denied(denylist []string, values ...string) bool {
+	for _, pattern := range denylist {
+		for _, value := range values {
+			if ok, _ := path.Match(pattern, value); ok {
+				return true
+			}
+		}
+	}
+	return false
 }
@@ -0,0 +1,63 @@
+package mapper
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/fluid-cloudnative/fluid-resource-mapper/pkg/k8s"
+	"github.com/fluid-cloudnative/fluid-resource-mapper/pkg/types"
+)
+
+func TestComponentRoles(t *testing.T) {
+	// The role labels each runtime's controller sets, empty for a component
+	// the runtime type does not deploy
+	tests := []struct {
+		runtimeType          string
+		master, worker, fuse string
+	}{
+		{runtimeType: "alluxio", master: "alluxio-master", worker: "alluxio-worker", fuse: "alluxio-fuse"},
+		{runtimeType: "jindo", master: "jindo-master", worker: "jindo-worker", fuse: "jindo-fuse"},
+		{runtimeType: "juicefs", worker: "juicefs-worker", fuse: "juicefs-fuse"},
+		{runtimeType: "goosefs", master: "goosefs-master", worker: "goosefs-worker", fuse: "goosefs-fuse"},
+		{runtimeType: "vineyard", master: "vineyard-master", worker: "vineyard-worker", fuse: "vineyard-fuse"},
+		{runtimeType: "efc", master: "efc-master", worker: "efc-worker", fuse: "efc-fuse"},
+		{runtimeType: "thin", fuse: "thin-fuse"},
+	}
+
+	covered := make(map[string]bool)
+	for _, tt := range tests {
+		covered[tt.runtimeType] = true
+		t.Run(tt.runtimeType, func(t *testing.T) {
+			expected := GetRuntimeComponents(types.RuntimeType(tt.runtimeType))
+			for _, c := range []struct {
+				component types.ComponentType
+				role      string
+				deployed  bool
+			}{
+				{types.ComponentMaster, tt.master, expected.HasMaster},
+				{types.ComponentWorker, tt.worker, expected.HasWorker},
+				{types.ComponentFuse, tt.fuse, expected.HasFuse},
+			} {
+				if (c.role != "") != c.deployed {
+					t.Errorf("%s role = %q, but GetRuntimeComponents reports deployed = %v", c.component, c.role, c.deployed)
+					continue
+				}
+				if c.role == "" {
+					continue
+				}
+				if !slices.Contains(ComponentRoles[c.component], c.role) {
+					t.Errorf("ComponentRoles[%s] = %v, missing %q", c.component, ComponentRoles[c.component], c.role)
+				}
+				if got := determineComponent(map[string]string{FluidLabels.Role: c.role}); got != c.component {
+					t.Errorf("determineComponent(role=%s) = %q, want %q", c.role, got, c.component)
+				}
+			}
+		})
+	}
+
+	for runtimeType := range k8s.RuntimeTypeToGVR {
+		if !covered[runtimeType] {
+			t.Errorf("runtime type %s has no role test case", runtimeType)
+		}
+	}
+}
@@ -3,7 +3,9 @@ package mapper
 
 import (
 	"fmt"
+	"strings"
 
+	"k8s.io/apimachinery/pkg/api/resource"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 
 	"github.com/fluid-cloudnative/fluid-resource-mapper/pkg/types"
@@ -15,7 +17,10 @@ func parseRuntime(obj *unstructured.Unstructured, runtimeType types.RuntimeType)
 		Name:      obj.GetName(),
 		Namespace: obj.GetNamespace(),
 		Type:      runtimeType,
+		Kind:      obj.GetKind(),
+		Owner:     ownerInfo(obj.GetOwnerReferences()),
 	}
+	node.RawSpec = rawSpec(obj)
 
 	// Parse status
 	status, _, _ := unstructured.NestedMap(obj.Object, "status")
@@ -31,26 +36,12 @@ func parseRuntime(obj *unstructured.Unstructured, runtimeType types.RuntimeType)
 			node.FusePhase = fusePhase
 		}
 
-		// Master ready status
-		masterCurrent := getInt64Field(status, "currentMasterNumberScheduled")
-		masterDesired := getInt64Field(status, "desiredMasterNumberScheduled")
-		if masterDesired > 0 {
-			node.MasterReady = fmt.Sprintf("%d/%d", masterCurrent, masterDesired)
-		}
-
-		// Worker ready status
-		workerCurrent := getInt64Field(status, "currentWorkerNumberScheduled")
-		workerDesired := getInt64Field(status, "desiredWorkerNumberScheduled")
-		if workerDesired > 0 {
-			node.WorkerReady = fmt.Sprintf("%d/%d", workerCurrent, workerDesired)
-		}
-
-		// Fuse ready status
-		fuseCurrent := getInt64Field(status, "currentFuseNumberScheduled")
-		fuseDesired := getInt64Field(status, "desiredFuseNumberScheduled")
-		if fuseDesired > 0 {
-			node.FuseReady = fmt.Sprintf("%d/%d", fuseCurrent, fuseDesired)
+		// Ready counts, whose fields differ between runtime types
+		parseStatus, ok := runtimeStatusParsers[runtimeType]
+		if !ok {
+			parseStatus = parseScheduledStatus
 		}
+		parseStatus(node, status)
 
 		// Parse conditions
 		if conditions, ok := status["conditions"].([]interface{}); ok {
@@ -68,14 +59,200 @@ func parseRuntime(obj *unstructured.Unstructured, runtimeType types.RuntimeType)
 		}
 	}
 
+	node.WorkerReplicas = specWorkerReplicas(obj)
+	node.Cache = parseCacheSummary(obj, node)
+
+	if runtimeType == types.RuntimeTypeThin {
+		if name, _, _ := unstructured.NestedString(obj.Object, "spec", "profileName"); name != "" {
+			node.Profile = &types.ThinRuntimeProfile{Name: name, FuseImage: fuseImage(obj)}
+		}
+	}
+
 	return node, nil
 }
 
+// fuseImage returns spec.fuse.image with its imageTag, e.g. "fluidcloudnative/s3fs:v1.0",
+// or "" when no image is set
+func fuseImage(obj *unstructured.Unstructured) string {
+	image, _, _ := unstructured.NestedString(obj.Object, "spec", "fuse", "image")
+	if image == "" {
+		return ""
+	}
+	if tag, _, _ := unstructured.NestedString(obj.Object, "spec", "fuse", "imageTag"); tag != "" {
+		image += ":" + tag
+	}
+	return image
+}
+
+// parseCacheSummary aggregates the cache of a runtime's workers. Each tiered
+// store level's per-worker quota is multiplied by the worker count; the used
+// amount comes from status.cacheStates. Returns nil when neither is set.
+func parseCacheSummary(obj *unstructured.Unstructured, node *types.RuntimeNode) *types.CacheSummary {
+	// NoCopy: mock objects carry plain ints, which the copying getters reject
+	levelsField, _, _ := unstructured.NestedFieldNoCopy(obj.Object, "spec", "tieredstore", "levels")
+	cacheStatesField, _, _ := unstructured.NestedFieldNoCopy(obj.Object, "status", "cacheStates")
+	levels, _ := levelsField.([]interface{})
+	cacheStates, _ := cacheStatesField.(map[string]interface{})
+	if len(levels) == 0 && len(cacheStates) == 0 {
+		return nil
+	}
+
+	summary := &types.CacheSummary{Workers: workerCount(obj, node)}
+	var total int64
+	for _, l := range levels {
+		level, ok := l.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		tier := types.CacheTier{
+			Medium: getStringField(level, "mediumtype"),
+			Path:   getStringField(level, "path"),
+		}
+		// quotaList holds one quota per path of a multi-path tier
+		quotas := strings.Split(getStringField(level, "quotaList"), ",")
+		if quota := getStringField(level, "quota"); quota != "" {
+			quotas = []string{quota}
+		}
+		var perWorker int64
+		for _, q := range quotas {
+			if bytes, ok := parseCacheSize(q); ok {
+				perWorker += bytes
+			}
+		}
+		if perWorker > 0 {
+			tier.Quota = formatBytes(perWorker)
+			tier.Capacity = formatBytes(perWorker * summary.Workers)
+			total += perWorker * summary.Workers
+		}
+		summary.Tiers = append(summary.Tiers, tier)
+	}
+
+	summary.Capacity = getStringField(cacheStates, "cacheCapacity")
+	if total > 0 {
+		summary.Capacity = formatBytes(total)
+	}
+	summary.Used = getStringField(cacheStates, "cached")
+
+	capacity, okCapacity := parseCacheSize(summary.Capacity)
+	used, okUsed := parseCacheSize(summary.Used)
+	if okCapacity && okUsed && capacity > 0 {
+		summary.Percentage = fmt.Sprintf("%.1f%%", float64(used)*100/float64(capacity))
+	}
+	return summary
+}
+
+// workerCount returns the desired worker replicas from the spec, falling
+// back to the desired count in the status
+func workerCount(obj *unstructured.Unstructured, node *types.RuntimeNode) int64 {
+	if node.WorkerReplicas > 0 {
+		return node.WorkerReplicas
+	}
+	var current, desired int64
+	if _, err := fmt.Sscanf(node.WorkerReady, "%d/%d", &current, &desired); err == nil {
+		return desired
+	}
+	return 0
+}
+
+// specWorkerReplicas returns the worker count in the Runtime spec:
+// spec.worker.replicas, falling back to spec.replicas. 0 means unset.
+func specWorkerReplicas(obj *unstructured.Unstructured) int64 {
+	spec, _, _ := unstructured.NestedFieldNoCopy(obj.Object, "spec")
+	specMap, _ := spec.(map[string]interface{})
+	if worker, ok := specMap["worker"].(map[string]interface{}); ok && getInt64Field(worker, "replicas") > 0 {
+		return getInt64Field(worker, "replicas")
+	}
+	return getInt64Field(specMap, "replicas")
+}
+
+// parseCacheSize parses a size as Kubernetes quantity ("2Gi") or as the
+// byte units Fluid reports in cache states ("2.00GiB")
+func parseCacheSize(s string) (int64, bool) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return 0, false
+	}
+	q, err := resource.ParseQuantity(strings.TrimSuffix(s, "B"))
+	if err != nil {
+		return 0, false
+	}
+	return q.Value(), true
+}
+
+// formatBytes formats a byte count as a binary quantity, e.g. "24Gi"
+func formatBytes(bytes int64) string {
+	return resource.NewQuantity(bytes, resource.BinarySI).String()
+}
+
+// runtimeStatusParsers fill a RuntimeNode's ready counts from the status
+// fields each runtime type reports; types not listed use parseScheduledStatus
+var runtimeStatusParsers = map[types.RuntimeType]func(node *types.RuntimeNode, status map[string]interface{}){
+	types.RuntimeTypeAlluxio:  parseScheduledStatus,
+	types.RuntimeTypeJindo:    parseScheduledStatus,
+	types.RuntimeTypeJuiceFS:  parseScheduledStatus,
+	types.RuntimeTypeGooseFS:  parseScheduledStatus,
+	types.RuntimeTypeVineyard: parseReadyStatus,
+	types.RuntimeTypeEFC:      parseReadyStatus,
+	types.RuntimeTypeThin:     parseFuseOnlyStatus,
+}
+
+// parseScheduledStatus reads the current/desired scheduled counts used by the
+// Alluxio-style runtimes
+func parseScheduledStatus(node *types.RuntimeNode, status map[string]interface{}) {
+	node.MasterReady = readyCount(status, "currentMasterNumberScheduled", "desiredMasterNumberScheduled")
+	node.WorkerReady = readyCount(status, "currentWorkerNumberScheduled", "desiredWorkerNumberScheduled")
+	node.FuseReady = readyCount(status, "currentFuseNumberScheduled", "desiredFuseNumberScheduled")
+}
+
+// parseReadyStatus reads the *NumberReady counts reported by Vineyard and EFC,
+// whose scheduled counts are not kept up to date. Components without a ready
+// count fall back to the scheduled count.
+func parseReadyStatus(node *types.RuntimeNode, status map[string]interface{}) {
+	parseScheduledStatus(node, status)
+	if hasField(status, "masterNumberReady") {
+		node.MasterReady = readyCount(status, "masterNumberReady", "desiredMasterNumberScheduled")
+	}
+	if hasField(status, "workerNumberReady") {
+		node.WorkerReady = readyCount(status, "workerNumberReady", "desiredWorkerNumberScheduled")
+	}
+	if hasField(status, "fuseNumberReady") {
+		node.FuseReady = readyCount(status, "fuseNumberReady", "desiredFuseNumberScheduled")
+	}
+}
+
+// parseFuseOnlyStatus reads the fuse counts of ThinRuntime, which has no
+// master or worker
+func parseFuseOnlyStatus(node *types.RuntimeNode, status map[string]interface{}) {
+	node.FuseReady = readyCount(status, "currentFuseNumberScheduled", "desiredFuseNumberScheduled")
+	if hasField(status, "fuseNumberReady") {
+		node.FuseReady = readyCount(status, "fuseNumberReady", "desiredFuseNumberScheduled")
+	}
+}
+
+// readyCount formats "current/desired" from two status fields, or "" if
+// nothing is desired
+func readyCount(status map[string]interface{}, currentKey, desiredKey string) string {
+	desired := getInt64Field(status, desiredKey)
+	if desired <= 0 {
+		return ""
+	}
+	return fmt.Sprintf("%d/%d", getInt64Field(status, currentKey), desired)
+}
+
+// hasField reports whether a status field is set
+func hasField(m map[string]interface{}, key string) bool {
+	_, ok := m[key]
+	return ok
+}
+
 // getInt64Field safely extracts an int64 field from a map
 func getInt64Field(m map[string]interface{}, key string) int64 {
 	if v, ok := m[key].(int64); ok {
 		return v
 	}
+	if v, ok := m[key].(int); ok {
+		return int64(v)
+	}
 	if v, ok := m[key].(float64); ok {
 		return int64(v)
 	}
@@ -84,13 +261,16 @@ func getInt64Field(m map[string]interface{}, key string) int64 {
 
 // RuntimeComponents defines which components each runtime type supports
 type RuntimeComponents struct {
-	HasMaster bool
-	HasWorker bool
-	HasFuse   bool
+	HasMaster bool `json:"master"`
+	HasWorker bool `json:"worker"`
+	HasFuse   bool `json:"fuse"`
 }
 
 // GetRuntimeComponents returns the component configuration for a runtime type
 func GetRuntimeComponents(runtimeType types.RuntimeType) RuntimeComponents {
+	if components, ok := registeredComponents[runtimeType]; ok {
+		return components
+	}
 	switch runtimeType {
 	case types.RuntimeTypeAlluxio, types.RuntimeTypeJindo, types.RuntimeTypeGooseFS, types.RuntimeTypeVineyard, types.RuntimeTypeEFC:
 		return RuntimeComponents{HasMaster: true, HasWorker: true, HasFuse: true}
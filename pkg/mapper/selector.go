@@ -0,0 +1,79 @@
+// Package mapper label selector construction
+package mapper
+
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/selection"
+)
+
+// SelectorBuilder composes a label selector from Fluid labels and validates each
+// requirement, so a malformed selector fails loudly instead of matching nothing
+type SelectorBuilder struct {
+	selector labels.Selector
+	err      error
+}
+
+// NewSelectorBuilder returns an empty selector builder
+func NewSelectorBuilder() *SelectorBuilder {
+	return &SelectorBuilder{selector: labels.NewSelector()}
+}
+
+// Release requires release=<name>
+func (b *SelectorBuilder) Release(name string) *SelectorBuilder {
+	return b.Equals(FluidLabels.Release, name)
+}
+
+// Dataset requires fluid.io/dataset=<namespace>-<name>
+func (b *SelectorBuilder) Dataset(namespace, name string) *SelectorBuilder {
+	return b.Equals(FluidLabels.Dataset, namespace+"-"+name)
+}
+
+// Role requires role=<role>
+func (b *SelectorBuilder) Role(role string) *SelectorBuilder {
+	return b.Equals(FluidLabels.Role, role)
+}
+
+// Component requires component=<component>
+func (b *SelectorBuilder) Component(component string) *SelectorBuilder {
+	return b.Equals(FluidLabels.Component, component)
+}
+
+// Equals requires key=value
+func (b *SelectorBuilder) Equals(key, value string) *SelectorBuilder {
+	if b.err != nil {
+		return b
+	}
+	req, err := labels.NewRequirement(key, selection.Equals, []string{value})
+	if err != nil {
+		b.err = fmt.Errorf("invalid label requirement %s=%s: %w", key, value, err)
+		return b
+	}
+	b.selector = b.selector.Add(*req)
+	return b
+}
+
+// Extra adds the requirements of a raw selector such as "shard in (a,b),tier!=cold".
+// An empty selector adds nothing.
+func (b *SelectorBuilder) Extra(selector string) *SelectorBuilder {
+	if b.err != nil || selector == "" {
+		return b
+	}
+	parsed, err := labels.Parse(selector)
+	if err != nil {
+		b.err = fmt.Errorf("invalid label selector %q: %w", selector, err)
+		return b
+	}
+	requirements, _ := parsed.Requirements()
+	b.selector = b.selector.Add(requirements...)
+	return b
+}
+
+// Build returns the selector string, or the first error encountered while composing it
+func (b *SelectorBuilder) Build() (string, error) {
+	if b.err != nil {
+		return "", b.err
+	}
+	return b.selector.String(), nil
+}
@@ -0,0 +1,93 @@
+// Package mapper pod resource and usage reporting
+package mapper
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/fluid-cloudnative/fluid-resource-mapper/pkg/types"
+)
+
+// podResourceDetails returns a pod's CPU and memory requests and limits, summed
+// over its containers, as Details entries. Unset values are omitted.
+func podResourceDetails(pod corev1.Pod) map[string]string {
+	requests := corev1.ResourceList{}
+	limits := corev1.ResourceList{}
+	for _, c := range pod.Spec.Containers {
+		addResources(requests, c.Resources.Requests)
+		addResources(limits, c.Resources.Limits)
+	}
+
+	details := make(map[string]string)
+	setQuantity(details, "cpuRequest", requests, corev1.ResourceCPU)
+	setQuantity(details, "memoryRequest", requests, corev1.ResourceMemory)
+	setQuantity(details, "cpuLimit", limits, corev1.ResourceCPU)
+	setQuantity(details, "memoryLimit", limits, corev1.ResourceMemory)
+	return details
+}
+
+// discoverPodUsage lists live pod usage from metrics.k8s.io, keyed by pod name
+func (m *Mapper) discoverPodUsage(ctx context.Context, namespace, labelSelector string) (map[string]corev1.ResourceList, []types.MappingWarning) {
+	list, err := m.client.ListPodMetrics(ctx, namespace, labelSelector)
+	if err != nil {
+		return nil, []types.MappingWarning{{
+			Level:      types.WarningLevelInfo,
+			Code:       "POD_METRICS_LIST_FAILED",
+			Message:    fmt.Sprintf("Failed to list pod metrics: %v", err),
+			Suggestion: "Check that metrics-server is installed in the cluster",
+		}}
+	}
+
+	usage := make(map[string]corev1.ResourceList, len(list.Items))
+	for _, item := range list.Items {
+		usage[item.GetName()] = podMetricsUsage(item)
+	}
+	return usage, nil
+}
+
+// podMetricsUsage sums the container usage of a PodMetrics object
+func podMetricsUsage(obj unstructured.Unstructured) corev1.ResourceList {
+	total := corev1.ResourceList{}
+	containers, _, _ := unstructured.NestedSlice(obj.Object, "containers")
+	for _, c := range containers {
+		container, ok := c.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		usage, _, _ := unstructured.NestedStringMap(container, "usage")
+		for name, value := range usage {
+			q, err := resource.ParseQuantity(value)
+			if err != nil {
+				continue
+			}
+			addResources(total, corev1.ResourceList{corev1.ResourceName(name): q})
+		}
+	}
+	return total
+}
+
+// usageDetails returns a pod's live CPU and memory usage as Details entries
+func usageDetails(usage corev1.ResourceList) map[string]string {
+	details := make(map[string]string)
+	setQuantity(details, "cpuUsage", usage, corev1.ResourceCPU)
+	setQuantity(details, "memoryUsage", usage, corev1.ResourceMemory)
+	return details
+}
+
+func addResources(total, add corev1.ResourceList) {
+	for name, q := range add {
+		sum := total[name]
+		sum.Add(q)
+		total[name] = sum
+	}
+}
+
+func setQuantity(details map[string]string, key string, list corev1.ResourceList, name corev1.ResourceName) {
+	if q, ok := list[name]; ok && !q.IsZero() {
+		details[key] = q.String()
+	}
+}
@@ -0,0 +1,141 @@
+// Package mapper naming-convention verification
+package mapper
+
+import (
+	"context"
+	"fmt"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+
+	"github.com/fluid-cloudnative/fluid-resource-mapper/pkg/types"
+)
+
+// expectedResource is a resource predicted from NamingConventions
+type expectedResource struct {
+	kind      string
+	name      string
+	namespace string
+	component types.ComponentType
+	optional  bool
+
+	// labeled resources must also match the release's label selector
+	labeled bool
+}
+
+// Verify checks that every resource the naming conventions predict for the
+// Dataset's runtimes exists under its conventional name: the master and
+// worker StatefulSets, the fuse DaemonSet, the Dataset's PVC and the
+// per-component ConfigMaps. Unlike label-based discovery it also catches a
+// workload that exists but is not labeled for its release, which is reported
+// as MISLABELED. The Dataset must be bound to at least one runtime.
+func (m *Mapper) Verify(ctx context.Context, name, namespace string, opts Options) (*types.VerifyReport, error) {
+	dataset, err := m.resolveDataset(ctx, name, namespace)
+	if err != nil {
+		return nil, classifyDatasetError(err)
+	}
+
+	report := &types.VerifyReport{
+		Dataset:   dataset.Name,
+		Namespace: dataset.Namespace,
+		Phase:     dataset.Phase,
+	}
+
+	runtimes, _ := m.resolveRuntimes(ctx, *dataset)
+	if len(runtimes) == 0 {
+		return report, fmt.Errorf("dataset %s/%s is not bound to a runtime (phase %s)", dataset.Namespace, dataset.Name, dataset.Phase)
+	}
+
+	for _, runtime := range runtimes {
+		selectors := releaseSelectors(runtime.Name, runtime.Namespace, opts)
+		for _, expected := range expectedResources(runtime, dataset) {
+			check := types.VerifyCheck{
+				Kind:      expected.kind,
+				Name:      expected.name,
+				Namespace: expected.namespace,
+				Component: expected.component,
+				Runtime:   runtime.Name,
+				Optional:  expected.optional,
+				Status:    types.VerifyFound,
+			}
+
+			obj, err := m.client.GetObjectMeta(ctx, expected.namespace, expected.kind, expected.name)
+			switch {
+			case apierrors.IsNotFound(err):
+				check.Status = types.VerifyMissing
+				// A sidecar-mode fuse has no DaemonSet to find
+				if expected.kind == ResourceKinds.DaemonSet {
+					if sidecars := m.fuseSidecars(ctx, runtime); len(sidecars) > 0 {
+						check.Optional = true
+						check.Message = fmt.Sprintf("fuse runs as a sidecar in %d application pod(s)", len(sidecars))
+					}
+				}
+			case err != nil:
+				return nil, fmt.Errorf("failed to get %s %s: %w", expected.kind, expected.name, err)
+			case expected.labeled && !matchesAny(selectors, obj.GetLabels()):
+				check.Status = types.VerifyMislabeled
+				check.Message = fmt.Sprintf("labels %v match none of %v", obj.GetLabels(), selectors)
+			}
+			report.Checks = append(report.Checks, check)
+		}
+	}
+
+	return report, nil
+}
+
+// expectedResources predicts the resources of a runtime from its type's
+// components and NamingConventions
+func expectedResources(runtime *types.RuntimeNode, dataset *types.DatasetNode) []expectedResource {
+	components := GetRuntimeComponents(runtime.Type)
+	ns := runtime.Namespace
+
+	var expected []expectedResource
+	if components.HasMaster {
+		expected = append(expected, expectedResource{kind: ResourceKinds.StatefulSet, name: NamingConventions.MasterStatefulSet(runtime.Name), namespace: ns, component: types.ComponentMaster, labeled: true})
+	}
+	if components.HasWorker {
+		expected = append(expected, expectedResource{kind: ResourceKinds.StatefulSet, name: NamingConventions.WorkerStatefulSet(runtime.Name), namespace: ns, component: types.ComponentWorker, labeled: true})
+	}
+	if components.HasFuse {
+		expected = append(expected, expectedResource{kind: ResourceKinds.DaemonSet, name: NamingConventions.FuseDaemonSet(runtime.Name), namespace: ns, component: types.ComponentFuse, labeled: true})
+	}
+	expected = append(expected, expectedResource{kind: ResourceKinds.PersistentVolumeClaim, name: NamingConventions.PVC(dataset.Name), namespace: dataset.Namespace, component: types.ComponentStorage})
+	if components.HasMaster {
+		expected = append(expected, expectedResource{kind: ResourceKinds.ConfigMap, name: NamingConventions.MasterConfig(runtime.Name), namespace: ns, component: types.ComponentConfig, optional: true, labeled: true})
+	}
+	if components.HasWorker {
+		expected = append(expected, expectedResource{kind: ResourceKinds.ConfigMap, name: NamingConventions.WorkerConfig(runtime.Name), namespace: ns, component: types.ComponentConfig, optional: true, labeled: true})
+	}
+	if components.HasFuse {
+		expected = append(expected, expectedResource{kind: ResourceKinds.ConfigMap, name: NamingConventions.FuseConfig(runtime.Name), namespace: ns, component: types.ComponentConfig, optional: true, labeled: true})
+	}
+	return expected
+}
+
+// releaseSelectors are the selectors discovery may use for a release: the
+// --label-key selector when set, otherwise the fluid.io/dataset and release ones
+func releaseSelectors(name, namespace string, opts Options) []string {
+	builders := []*SelectorBuilder{NewSelectorBuilder().Dataset(namespace, name), NewSelectorBuilder().Release(name)}
+	if opts.LabelKey != "" {
+		builders = []*SelectorBuilder{NewSelectorBuilder().Equals(opts.LabelKey, name)}
+	}
+
+	var selectors []string
+	for _, b := range builders {
+		if selector, err := b.Build(); err == nil {
+			selectors = append(selectors, selector)
+		}
+	}
+	return selectors
+}
+
+// matchesAny reports whether the labels match at least one of the selectors
+func matchesAny(selectors []string, objLabels map[string]string) bool {
+	for _, s := range selectors {
+		selector, err := labels.Parse(s)
+		if err == nil && selector.Matches(labels.Set(objLabels)) {
+			return true
+		}
+	}
+	return false
+}
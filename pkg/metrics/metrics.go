@@ -0,0 +1,73 @@
+// Package metrics exposes Fluid Resource Mapper results as Prometheus metrics.
+// A Collector turns a ResourceGraph into gauges that can be scraped from a
+// periodic mapping job, so datasets drifting unhealthy can be alerted on.
+package metrics
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/fluid-cloudnative/fluid-resource-mapper/pkg/types"
+)
+
+const namespace = "fluid_mapper"
+
+// Collector holds the gauges updated from mapping results
+type Collector struct {
+	resources        *prometheus.GaugeVec
+	warnings         *prometheus.GaugeVec
+	cachedPercentage *prometheus.GaugeVec
+}
+
+// NewCollector creates the mapper gauges and registers them with reg
+func NewCollector(reg prometheus.Registerer) *Collector {
+	c := &Collector{
+		resources: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Name:      "resources_total",
+			Help:      "Number of Kubernetes resources discovered for a Dataset.",
+		}, []string{"dataset", "namespace", "component", "kind"}),
+		warnings: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Name:      "warnings_total",
+			Help:      "Number of mapping warnings for a Dataset.",
+		}, []string{"dataset", "namespace", "level", "code"}),
+		cachedPercentage: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Name:      "dataset_cached_percentage",
+			Help:      "Percentage of the Dataset currently cached.",
+		}, []string{"dataset", "namespace"}),
+	}
+
+	reg.MustRegister(c.resources, c.warnings, c.cachedPercentage)
+	return c
+}
+
+// Update replaces the series of the graph's Dataset with the values from graph
+func (c *Collector) Update(graph *types.ResourceGraph) {
+	dataset := prometheus.Labels{
+		"dataset":   graph.Dataset.Name,
+		"namespace": graph.Dataset.Namespace,
+	}
+
+	// Drop series from the previous mapping so resolved warnings and deleted
+	// resources do not linger
+	c.resources.DeletePartialMatch(dataset)
+	c.warnings.DeletePartialMatch(dataset)
+
+	for _, r := range graph.Resources {
+		c.resources.WithLabelValues(graph.Dataset.Name, graph.Dataset.Namespace, string(r.Component), r.Kind).Inc()
+	}
+
+	for _, w := range graph.Warnings {
+		c.warnings.WithLabelValues(graph.Dataset.Name, graph.Dataset.Namespace, string(w.Level), w.Code).Inc()
+	}
+
+	if pct, err := strconv.ParseFloat(strings.TrimSuffix(graph.Dataset.CachedPercentage, "%"), 64); err == nil {
+		c.cachedPercentage.With(dataset).Set(pct)
+	} else {
+		c.cachedPercentage.Delete(dataset)
+	}
+}
@@ -0,0 +1,155 @@
+// Package notify sends Fluid Resource Mapper results to external alerting
+// systems. A Webhook POSTs an Alert for an unhealthy ResourceGraph so a
+// periodic mapping job can feed Slack, PagerDuty or any HTTP receiver.
+package notify
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/fluid-cloudnative/fluid-resource-mapper/pkg/types"
+)
+
+// Defaults used by NewWebhook
+const (
+	DefaultAttempts = 3
+	DefaultBackoff  = time.Second
+	DefaultTimeout  = 10 * time.Second
+)
+
+// Alert is the JSON payload POSTed to the webhook
+type Alert struct {
+	// Dataset is the name of the unhealthy Dataset
+	Dataset string `json:"dataset"`
+
+	// Namespace of the Dataset
+	Namespace string `json:"namespace"`
+
+	// Cluster is the cluster name from the graph metadata, if known
+	Cluster string `json:"cluster,omitempty"`
+
+	// HealthScore and Grade summarize the graph (see ResourceGraph.HealthScore)
+	HealthScore int    `json:"healthScore"`
+	Grade       string `json:"grade"`
+
+	// Warnings are all warnings of the mapping, most severe first
+	Warnings []types.MappingWarning `json:"warnings"`
+
+	// Timestamp is when the mapping was performed
+	Timestamp time.Time `json:"timestamp"`
+}
+
+// NewAlert builds the alert payload for a graph
+func NewAlert(graph *types.ResourceGraph) Alert {
+	score, grade := graph.HealthScore()
+	return Alert{
+		Dataset:     graph.Dataset.Name,
+		Namespace:   graph.Dataset.Namespace,
+		Cluster:     graph.Metadata.ClusterName,
+		HealthScore: score,
+		Grade:       grade,
+		Warnings:    graph.WarningsAtLeast(types.WarningLevelInfo),
+		Timestamp:   graph.Metadata.MappedAt,
+	}
+}
+
+// Webhook POSTs alerts to a URL, retrying failed deliveries
+type Webhook struct {
+	// URL receives the alerts
+	URL string
+
+	// Client sends the requests; its Timeout bounds each attempt
+	Client *http.Client
+
+	// Attempts is the total number of tries per alert, at least 1
+	Attempts int
+
+	// Backoff is the wait before the first retry, doubled for each further retry
+	Backoff time.Duration
+}
+
+// NewWebhook creates a Webhook for url with the default attempts, backoff and timeout
+func NewWebhook(url string) *Webhook {
+	return &Webhook{
+		URL:      url,
+		Client:   &http.Client{Timeout: DefaultTimeout},
+		Attempts: DefaultAttempts,
+		Backoff:  DefaultBackoff,
+	}
+}
+
+// Notify sends the graph's alert when it has error-level warnings and
+// reports whether one was sent. Healthy graphs send nothing.
+func (w *Webhook) Notify(ctx context.Context, graph *types.ResourceGraph) (bool, error) {
+	if graph.IsHealthy() {
+		return false, nil
+	}
+	return true, w.Send(ctx, NewAlert(graph))
+}
+
+// Send POSTs the alert, retrying on network errors, 429 and 5xx responses
+// until Attempts is exhausted or ctx is done. Other 4xx responses are not
+// retried since sending the same payload again cannot succeed.
+func (w *Webhook) Send(ctx context.Context, alert Alert) error {
+	body, err := json.Marshal(alert)
+	if err != nil {
+		return fmt.Errorf("failed to marshal alert: %w", err)
+	}
+
+	backoff := w.Backoff
+	var lastErr error
+	for attempt := 1; attempt <= max(w.Attempts, 1); attempt++ {
+		if attempt > 1 {
+			select {
+			case <-ctx.Done():
+				return fmt.Errorf("alert not delivered after %d attempt(s): %w (last error: %v)", attempt-1, ctx.Err(), lastErr)
+			case <-time.After(backoff):
+			}
+			backoff *= 2
+		}
+
+		retry, err := w.post(ctx, body)
+		if err == nil {
+			return nil
+		}
+		lastErr = err
+		if !retry {
+			break
+		}
+	}
+	return fmt.Errorf("alert not delivered to %s: %w", w.URL, lastErr)
+}
+
+// post makes one delivery attempt and reports whether a failure is worth retrying
+func (w *Webhook) post(ctx context.Context, body []byte) (bool, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, w.URL, bytes.NewReader(body))
+	if err != nil {
+		return false, fmt.Errorf("invalid webhook request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	client := w.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return true, err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))
+
+	switch {
+	case resp.StatusCode >= 200 && resp.StatusCode < 300:
+		return false, nil
+	case resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= 500:
+		return true, fmt.Errorf("webhook returned %s", resp.Status)
+	default:
+		return false, fmt.Errorf("webhook returned %s", resp.Status)
+	}
+}
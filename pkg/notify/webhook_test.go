@@ -0,0 +1,172 @@
+package notify
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/fluid-cloudnative/fluid-resource-mapper/pkg/types"
+)
+
+// unhealthyGraph returns a graph with one error-level warning
+func unhealthyGraph() *types.ResourceGraph {
+	return &types.ResourceGraph{
+		Dataset: types.DatasetNode{Name: "demo-data", Namespace: "default"},
+		Warnings: []types.MappingWarning{{
+			Level:    types.WarningLevelError,
+			Code:     types.WarningCodes.MasterMissing,
+			Message:  "No Master StatefulSet found",
+			Resource: "demo-data-master",
+		}},
+		Metadata: types.GraphMetadata{
+			ClusterName: "prod-east",
+			MappedAt:    time.Date(2026, 10, 15, 12, 0, 0, 0, time.UTC),
+		},
+	}
+}
+
+// testWebhook returns a Webhook for url that retries without waiting long
+func testWebhook(url string) *Webhook {
+	w := NewWebhook(url)
+	w.Backoff = time.Millisecond
+	return w
+}
+
+func TestNotifyPayload(t *testing.T) {
+	var got Alert
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		if r.Method != http.MethodPost {
+			t.Errorf("method = %s, want POST", r.Method)
+		}
+		if ct := r.Header.Get("Content-Type"); ct != "application/json" {
+			t.Errorf("Content-Type = %q, want application/json", ct)
+		}
+		if err := json.NewDecoder(r.Body).Decode(&got); err != nil {
+			t.Errorf("decoding payload: %v", err)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	graph := unhealthyGraph()
+	sent, err := testWebhook(server.URL).Notify(context.Background(), graph)
+	if err != nil || !sent {
+		t.Fatalf("Notify() = %v, %v, want true, nil", sent, err)
+	}
+	if n := requests.Load(); n != 1 {
+		t.Fatalf("webhook received %d requests, want 1", n)
+	}
+
+	score, grade := graph.HealthScore()
+	if got.Dataset != "demo-data" || got.Namespace != "default" || got.Cluster != "prod-east" {
+		t.Errorf("alert identifies %s/%s on %q, want default/demo-data on prod-east", got.Namespace, got.Dataset, got.Cluster)
+	}
+	if got.HealthScore != score || got.Grade != grade {
+		t.Errorf("alert health = %d (%s), want %d (%s)", got.HealthScore, got.Grade, score, grade)
+	}
+	if len(got.Warnings) != 1 || got.Warnings[0].Code != types.WarningCodes.MasterMissing {
+		t.Errorf("alert warnings = %+v, want the MASTER_MISSING warning", got.Warnings)
+	}
+	if !got.Timestamp.Equal(graph.Metadata.MappedAt) {
+		t.Errorf("alert timestamp = %v, want %v", got.Timestamp, graph.Metadata.MappedAt)
+	}
+}
+
+func TestNotifyHealthyGraphSendsNothing(t *testing.T) {
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+	}))
+	defer server.Close()
+
+	graph := unhealthyGraph()
+	graph.Warnings[0].Level = types.WarningLevelWarning
+	sent, err := testWebhook(server.URL).Notify(context.Background(), graph)
+	if err != nil || sent {
+		t.Fatalf("Notify() = %v, %v, want false, nil", sent, err)
+	}
+	if n := requests.Load(); n != 0 {
+		t.Errorf("webhook received %d requests, want 0", n)
+	}
+}
+
+func TestSendStatusHandling(t *testing.T) {
+	tests := []struct {
+		name         string
+		statuses     []int // response per attempt; the last one repeats
+		wantErr      bool
+		wantRequests int32
+	}{
+		{"accepted", []int{http.StatusOK}, false, 1},
+		{"client error is not retried", []int{http.StatusBadRequest}, true, 1},
+		{"server error is retried until attempts run out", []int{http.StatusServiceUnavailable}, true, DefaultAttempts},
+		{"rate limit is retried", []int{http.StatusTooManyRequests, http.StatusAccepted}, false, 2},
+		{"recovers after a server error", []int{http.StatusBadGateway, http.StatusInternalServerError, http.StatusOK}, false, 3},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var requests atomic.Int32
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				n := int(requests.Add(1))
+				w.WriteHeader(tt.statuses[min(n, len(tt.statuses))-1])
+			}))
+			defer server.Close()
+
+			err := testWebhook(server.URL).Send(context.Background(), NewAlert(unhealthyGraph()))
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Send() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if n := requests.Load(); n != tt.wantRequests {
+				t.Errorf("webhook received %d requests, want %d", n, tt.wantRequests)
+			}
+		})
+	}
+}
+
+func TestSendTimeout(t *testing.T) {
+	// The receiver hangs until the test ends
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+		case <-release:
+		}
+	}))
+	defer server.Close()
+	defer close(release)
+
+	t.Run("client timeout bounds each attempt", func(t *testing.T) {
+		w := testWebhook(server.URL)
+		w.Client.Timeout = 20 * time.Millisecond
+		w.Attempts = 2
+
+		start := time.Now()
+		err := w.Send(context.Background(), NewAlert(unhealthyGraph()))
+		if err == nil {
+			t.Fatal("Send() succeeded against a hanging receiver")
+		}
+		if elapsed := time.Since(start); elapsed > 2*time.Second {
+			t.Errorf("Send() took %v, want it bounded by the client timeout", elapsed)
+		}
+	})
+
+	t.Run("context deadline stops retries", func(t *testing.T) {
+		w := testWebhook(server.URL)
+		w.Backoff = time.Hour
+
+		ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+		defer cancel()
+		err := w.Send(ctx, NewAlert(unhealthyGraph()))
+		if err == nil || !strings.Contains(err.Error(), "deadline exceeded") {
+			t.Errorf("Send() error = %v, want a deadline exceeded error", err)
+		}
+	})
+}
@@ -0,0 +1,232 @@
+// Package types catalog of every warning code
+package types
+
+// warningCatalog holds a template for each code in WarningCodes, in the same
+// order. Messages describe the condition generically; fired warnings carry
+// specifics such as resource names.
+var warningCatalog = []MappingWarning{
+	{
+		Level:      WarningLevelError,
+		Code:       WarningCodes.DatasetNotFound,
+		Message:    "The Dataset could not be fetched",
+		Suggestion: "Verify the Dataset name and namespace are correct",
+	},
+	{
+		Level:      WarningLevelWarning,
+		Code:       WarningCodes.RuntimeNotBound,
+		Message:    "No Runtime is bound to the Dataset",
+		Suggestion: "Create a Runtime CR with the same name as the Dataset",
+	},
+	{
+		Level:      WarningLevelWarning,
+		Code:       WarningCodes.RuntimeNotFound,
+		Message:    "The Runtime referenced by the Dataset does not exist",
+		Suggestion: "Create a Runtime CR with the same name as the Dataset",
+	},
+	{
+		Level:      WarningLevelError,
+		Code:       WarningCodes.MasterMissing,
+		Message:    "No Master StatefulSet found",
+		Suggestion: "Check if the runtime controller is running correctly",
+	},
+	{
+		Level:      WarningLevelError,
+		Code:       WarningCodes.WorkerMissing,
+		Message:    "No Worker StatefulSet found",
+		Suggestion: "Check if the runtime controller is running correctly",
+	},
+	{
+		Level:      WarningLevelWarning,
+		Code:       WarningCodes.FuseMissing,
+		Message:    "No Fuse DaemonSet found",
+		Suggestion: "Fuse pods are created on-demand when data is accessed",
+	},
+	{
+		Level:      WarningLevelWarning,
+		Code:       WarningCodes.PodsNotReady,
+		Message:    "Some pods are not ready",
+		Suggestion: "Check the pod events and container logs",
+	},
+	{
+		Level:      WarningLevelError,
+		Code:       WarningCodes.PVCMissing,
+		Message:    "The Dataset's PersistentVolumeClaim was not found",
+		Suggestion: "Check that the Runtime is ready; the PVC is created once it is",
+	},
+	{
+		Level:      WarningLevelWarning,
+		Code:       WarningCodes.PVNotBound,
+		Message:    "The Dataset's PersistentVolume is not bound",
+		Suggestion: "Check the PersistentVolume's claimRef and the PVC's events",
+	},
+	{
+		Level:      WarningLevelWarning,
+		Code:       WarningCodes.ConfigMapMissing,
+		Message:    "An expected runtime ConfigMap was not found",
+		Suggestion: "Check the runtime controller logs for errors while rendering the configuration",
+	},
+	{
+		Level:      WarningLevelWarning,
+		Code:       WarningCodes.OrphanedResource,
+		Message:    "A resource has Fluid labels but no owner reference",
+		Suggestion: "Check whether the Runtime was deleted or its controller failed to clean up",
+	},
+	{
+		Level:      WarningLevelWarning,
+		Code:       WarningCodes.UnknownRuntimeType,
+		Message:    "The runtime type is not known to the mapper",
+		Suggestion: "Register the runtime type with --runtime-config",
+	},
+	{
+		Level:      WarningLevelWarning,
+		Code:       WarningCodes.PartialCreation,
+		Message:    "The Runtime is partially created: some expected components are missing",
+		Suggestion: "Check the runtime controller logs and events for errors while creating the missing components",
+	},
+	{
+		Level:      WarningLevelInfo,
+		Code:       WarningCodes.ScalingInProgress,
+		Message:    "A StatefulSet is scaling or rolling out",
+		Suggestion: "Not-ready pods are expected until the StatefulSet converges",
+	},
+	{
+		Level:      WarningLevelWarning,
+		Code:       WarningCodes.DeletionInProgress,
+		Message:    "A resource is being deleted",
+		Suggestion: "If deletion does not finish, check the resource's finalizers",
+	},
+	{
+		Level:      WarningLevelWarning,
+		Code:       WarningCodes.ComponentNotReady,
+		Message:    "The Runtime reports a component PartialReady (warning) or Failed (error)",
+		Suggestion: "Inspect the pods of the component",
+	},
+	{
+		Level:      WarningLevelWarning,
+		Code:       WarningCodes.PodStaleRevision,
+		Message:    "A pod runs an outdated StatefulSet revision",
+		Suggestion: "Check the StatefulSet rollout; delete the pod to force an update if it uses the OnDelete strategy",
+	},
+	{
+		Level:      WarningLevelError,
+		Code:       WarningCodes.MappingIncomplete,
+		Message:    "Mapping was cancelled or timed out before completing",
+		Suggestion: "The graph is partial; check API server health or increase the timeout",
+	},
+	{
+		Level:      WarningLevelInfo,
+		Code:       WarningCodes.DataMigrateRunning,
+		Message:    "A DataMigrate is running",
+		Suggestion: "Expect elevated cache and IO activity until the migration completes",
+	},
+	{
+		Level:      WarningLevelWarning,
+		Code:       WarningCodes.ServiceNoEndpoints,
+		Message:    "A Service has no ready endpoints although its StatefulSet has ready pods",
+		Suggestion: "Compare the Service selector with the pod labels",
+	},
+	{
+		Level:      WarningLevelInfo,
+		Code:       WarningCodes.RuntimeTypeProbed,
+		Message:    "The Dataset status lists no runtimes; the runtime type was inferred by probing",
+		Suggestion: "Upgrade Fluid so the Dataset status records its bound runtimes",
+	},
+	{
+		Level:      WarningLevelInfo,
+		Code:       WarningCodes.DataOperationInProgress,
+		Message:    "A data operation recorded on the Dataset status is in progress",
+		Suggestion: "Expect cache and IO activity until it completes; check its CR for progress",
+	},
+	{
+		Level:      WarningLevelError,
+		Code:       WarningCodes.UFSUnreachable,
+		Message:    "A Dataset condition shows a failing UFS mount",
+		Suggestion: "Check the mount point URI, its credentials and network access from the runtime pods",
+	},
+	{
+		Level:      WarningLevelError,
+		Code:       WarningCodes.PVMismatch,
+		Message:    "The Dataset's PersistentVolume is bound to another claim or shared by several PVCs",
+		Suggestion: "Check the PV's claimRef; another Dataset, e.g. one left over from a rename, may be using its volume",
+	},
+	{
+		Level:      WarningLevelWarning,
+		Code:       WarningCodes.StorageClassMissing,
+		Message:    "A Pending PVC references a StorageClass that does not exist",
+		Suggestion: "Create the StorageClass or set the PVC's storageClassName to an existing one",
+	},
+	{
+		Level:      WarningLevelError,
+		Code:       WarningCodes.ThinProfileMissing,
+		Message:    "The ThinRuntimeProfile referenced by a ThinRuntime does not exist",
+		Suggestion: "Create the ThinRuntimeProfile or fix the ThinRuntime's spec.profileName",
+	},
+	{
+		Level:      WarningLevelWarning,
+		Code:       WarningCodes.ReplicaDrift,
+		Message:    "The worker StatefulSet's replica count differs from the Runtime spec",
+		Suggestion: "Check that the runtime controller is running and reconciling; its logs should show why the StatefulSet was not updated",
+	},
+	{
+		Level:   WarningLevelInfo,
+		Code:    WarningCodes.RuntimeRefMismatch,
+		Message: "The Dataset is bound to a Runtime with another name or in another namespace",
+	},
+	{
+		Level:      WarningLevelWarning,
+		Code:       WarningCodes.WaitingForConsumer,
+		Message:    "A PVC is Pending because its StorageClass binds on first consumer",
+		Suggestion: "The claim binds once a pod using it is scheduled; check that such a pod exists and can be scheduled",
+	},
+	{
+		Level:      WarningLevelError,
+		Code:       WarningCodes.ControllerUnavailable,
+		Message:    "Runtime workloads are missing and the runtime controller Deployment is missing, scaled to zero or not ready",
+		Suggestion: "Restore the runtime controller in the Fluid namespace; check its pods' events and logs",
+	},
+	{
+		Level:      WarningLevelInfo,
+		Code:       WarningCodes.ReleaseLabelMissing,
+		Message:    "A runtime workload was found by its app label and owner, not by the release selector",
+		Suggestion: "Label the resource with release set to the runtime name so tools selecting by release find it",
+	},
+	{
+		Level:      WarningLevelError,
+		Code:       WarningCodes.InvalidLabelSelector,
+		Message:    "No label selector can be built for a runtime, so its resources were not discovered",
+		Suggestion: "Check that the runtime name is a valid label value",
+	},
+	{
+		Level:   WarningLevelInfo,
+		Code:    WarningCodes.EventListFailed,
+		Message: "The Events of a pod that is not ready could not be listed, so they are not shown",
+	},
+	{
+		Level:   WarningLevelWarning,
+		Code:    WarningCodes.ServiceListFailed,
+		Message: "The Services of a runtime could not be listed, so they and their endpoints are not shown",
+	},
+	{
+		Level:   WarningLevelInfo,
+		Code:    WarningCodes.EndpointsGetFailed,
+		Message: "The Endpoints of a Service could not be fetched, so its ready endpoints are unknown",
+	},
+	{
+		Level:   WarningLevelWarning,
+		Code:    WarningCodes.PodListFailed,
+		Message: "The pods of a runtime could not be listed, so no pods or pod warnings are shown",
+	},
+	{
+		Level:   WarningLevelInfo,
+		Code:    WarningCodes.StorageClassGetFailed,
+		Message: "The StorageClass of a Pending PVC could not be fetched, so why the claim is Pending is unknown",
+	},
+}
+
+// WarningCatalog returns a template for every warning code in WarningCodes
+// with its default level, a generic message and the default suggestion, so
+// that UIs can show help for any code, not only the ones a mapping raised.
+// Resource is always empty. The returned slice is a copy.
+func WarningCatalog() []MappingWarning {
+	return append([]MappingWarning(nil), warningCatalog...)
+}
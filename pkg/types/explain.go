@@ -0,0 +1,227 @@
+// Package types root-cause explanations built from correlated warnings
+package types
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// ExplainRule correlates warning codes into a likely root cause. A rule
+// matches when every one of its codes was raised and at least one of them
+// is not already explained by an earlier rule.
+type ExplainRule struct {
+	// Codes must all be present for the rule to match
+	Codes []string
+
+	// Cause is the one-line root cause reported when the rule matches
+	Cause string
+}
+
+// ExplainRules is the ordered correlation table used by Explain. More
+// specific rules (more codes) come before the single-code rules they refine.
+var ExplainRules = []ExplainRule{
+	{
+		Codes: []string{WarningCodes.DatasetNotFound},
+		Cause: "The Dataset does not exist; check its name and namespace",
+	},
+	{
+		Codes: []string{WarningCodes.MappingIncomplete},
+		Cause: "The mapping was cut short, so the other findings may be incomplete",
+	},
+	{
+		Codes: []string{WarningCodes.ControllerUnavailable, WarningCodes.MasterMissing, WarningCodes.WorkerMissing},
+		Cause: "The runtime controller is down, so the runtime workloads were never created; fix the controller first",
+	},
+	{
+		Codes: []string{WarningCodes.ControllerUnavailable},
+		Cause: "The runtime controller is down, so the Runtime is not being reconciled",
+	},
+	{
+		Codes: []string{WarningCodes.RuntimeNotBound, WarningCodes.WorkerMissing},
+		Cause: "Runtime controller likely not reconciling: the Dataset is unbound and no workers were created",
+	},
+	{
+		Codes: []string{WarningCodes.RuntimeNotBound},
+		Cause: "No Runtime has bound the Dataset; create a Runtime with the Dataset's name or check the runtime controller",
+	},
+	{
+		Codes: []string{WarningCodes.RuntimeNotFound},
+		Cause: "The Runtime referenced by the Dataset does not exist",
+	},
+	{
+		Codes: []string{WarningCodes.MasterMissing, WarningCodes.WorkerMissing},
+		Cause: "Runtime controller did not create the runtime workloads; check its logs",
+	},
+	{
+		Codes: []string{WarningCodes.PartialCreation},
+		Cause: "Runtime creation stopped partway; check the runtime controller logs and events",
+	},
+	{
+		Codes: []string{WarningCodes.DeletionInProgress, WarningCodes.OrphanedResource},
+		Cause: "A deletion is stuck and left resources without owners; check for blocking finalizers",
+	},
+	{
+		Codes: []string{WarningCodes.DeletionInProgress},
+		Cause: "Resources are being deleted; the mapping reflects a teardown in progress",
+	},
+	{
+		Codes: []string{WarningCodes.OrphanedResource},
+		Cause: "Resources outlived their Runtime; the controller failed to clean them up",
+	},
+	{
+		Codes: []string{WarningCodes.ComponentNotReady, WarningCodes.PodsNotReady},
+		Cause: "Runtime pods are failing to become ready; check pod events and container logs",
+	},
+	{
+		Codes: []string{WarningCodes.MasterMissing},
+		Cause: "The master StatefulSet is missing, so the cache cannot serve data",
+	},
+	{
+		Codes: []string{WarningCodes.WorkerMissing},
+		Cause: "The worker StatefulSet is missing, so nothing is cached",
+	},
+	{
+		Codes: []string{WarningCodes.UFSUnreachable},
+		Cause: "The Dataset's underlying storage cannot be mounted; check the mount URI, credentials and network access",
+	},
+	{
+		Codes: []string{WarningCodes.ThinProfileMissing},
+		Cause: "The ThinRuntime's profile does not exist, so no fuse can be created to mount the storage",
+	},
+	{
+		Codes: []string{WarningCodes.PVCMissing},
+		Cause: "The Dataset's PVC was not created, so applications cannot mount it",
+	},
+	{
+		Codes: []string{WarningCodes.PVMismatch},
+		Cause: "The Dataset's PersistentVolume belongs to another claim, so applications may read another Dataset's data",
+	},
+	{
+		Codes: []string{WarningCodes.PVNotBound},
+		Cause: "The Dataset's PersistentVolume is not bound to its claim",
+	},
+	{
+		Codes: []string{WarningCodes.StorageClassMissing},
+		Cause: "A PVC cannot bind because its StorageClass does not exist",
+	},
+	{
+		Codes: []string{WarningCodes.WaitingForConsumer},
+		Cause: "A PVC is waiting for a consuming pod before it binds (volumeBindingMode: WaitForFirstConsumer)",
+	},
+	{
+		Codes: []string{WarningCodes.ServiceNoEndpoints},
+		Cause: "A Service selector does not match the ready pods, so clients cannot reach them",
+	},
+	{
+		Codes: []string{WarningCodes.ReplicaDrift},
+		Cause: "The runtime controller has not applied the Runtime's worker replica count; it is likely stuck",
+	},
+	{
+		Codes: []string{WarningCodes.PodStaleRevision, WarningCodes.ScalingInProgress},
+		Cause: "A rollout is in progress; some pods still run the old revision",
+	},
+	{
+		Codes: []string{WarningCodes.PodStaleRevision},
+		Cause: "A rollout is stuck with pods on the old revision",
+	},
+	{
+		Codes: []string{WarningCodes.ComponentNotReady},
+		Cause: "The Runtime reports a component not ready",
+	},
+	{
+		Codes: []string{WarningCodes.PodsNotReady},
+		Cause: "Some pods are not ready",
+	},
+	{
+		Codes: []string{WarningCodes.FuseMissing},
+		Cause: "Fuse is not deployed yet; it starts on demand when an application mounts the Dataset",
+	},
+	{
+		Codes: []string{WarningCodes.ScalingInProgress},
+		Cause: "A StatefulSet is scaling or rolling out",
+	},
+}
+
+// Explanation is a root cause derived from one or more warnings
+type Explanation struct {
+	Level WarningLevel `json:"level"`
+	Cause string       `json:"cause"`
+	Codes []string     `json:"codes"`
+}
+
+// Explanations applies ExplainRules to the graph's warnings and returns the
+// likely root causes, most severe first. Warnings no rule covers are
+// reported with their own message.
+func (g *ResourceGraph) Explanations() []Explanation {
+	levels := make(map[string]WarningLevel)
+	for _, w := range g.Warnings {
+		if level, ok := levels[w.Code]; !ok || w.Level.Severity() > level.Severity() {
+			levels[w.Code] = w.Level
+		}
+	}
+
+	explained := make(map[string]bool)
+	var explanations []Explanation
+	for _, rule := range ExplainRules {
+		matched, fresh := true, false
+		level := WarningLevel("")
+		for _, code := range rule.Codes {
+			codeLevel, ok := levels[code]
+			if !ok {
+				matched = false
+				break
+			}
+			if !explained[code] {
+				fresh = true
+			}
+			if codeLevel.Severity() > level.Severity() {
+				level = codeLevel
+			}
+		}
+		if !matched || !fresh {
+			continue
+		}
+		for _, code := range rule.Codes {
+			explained[code] = true
+		}
+		explanations = append(explanations, Explanation{Level: level, Cause: rule.Cause, Codes: rule.Codes})
+	}
+
+	for _, w := range g.Warnings {
+		if explained[w.Code] {
+			continue
+		}
+		explained[w.Code] = true
+		explanations = append(explanations, Explanation{Level: w.Level, Cause: w.Message, Codes: []string{w.Code}})
+	}
+
+	sort.SliceStable(explanations, func(i, j int) bool {
+		return explanations[i].Level.Severity() > explanations[j].Level.Severity()
+	})
+	return explanations
+}
+
+// Explain returns a short ranked narrative of why the Dataset is unhealthy
+func (g *ResourceGraph) Explain() string {
+	subject := "The Dataset"
+	if g.Dataset.Name != "" {
+		subject = fmt.Sprintf("Dataset %s/%s", g.Dataset.Namespace, g.Dataset.Name)
+	}
+
+	explanations := g.Explanations()
+	if len(explanations) == 0 {
+		return subject + " is healthy: no warnings were raised.\n"
+	}
+
+	var b strings.Builder
+	if g.IsHealthy() {
+		fmt.Fprintf(&b, "%s has no errors. Findings, most severe first:\n", subject)
+	} else {
+		fmt.Fprintf(&b, "%s is unhealthy. Likely causes, most severe first:\n", subject)
+	}
+	for i, e := range explanations {
+		fmt.Fprintf(&b, "%d. [%s] %s (%s)\n", i+1, e.Level, e.Cause, strings.Join(e.Codes, ", "))
+	}
+	return b.String()
+}
@@ -0,0 +1,157 @@
+package types
+
+import (
+	"reflect"
+	"testing"
+)
+
+// ruleCause returns the cause of the rule with exactly these codes
+func ruleCause(t *testing.T, codes ...string) string {
+	t.Helper()
+	for _, rule := range ExplainRules {
+		if reflect.DeepEqual(rule.Codes, codes) {
+			return rule.Cause
+		}
+	}
+	t.Fatalf("no rule for %v", codes)
+	return ""
+}
+
+func warning(level WarningLevel, code string) MappingWarning {
+	return MappingWarning{Level: level, Code: code, Message: code + " raised"}
+}
+
+func TestExplanationsMultiCodeRuleWins(t *testing.T) {
+	graph := &ResourceGraph{Warnings: []MappingWarning{
+		warning(WarningLevelWarning, WarningCodes.PodStaleRevision),
+		warning(WarningLevelInfo, WarningCodes.ScalingInProgress),
+	}}
+
+	want := []Explanation{{
+		Level: WarningLevelWarning,
+		Cause: ruleCause(t, WarningCodes.PodStaleRevision, WarningCodes.ScalingInProgress),
+		Codes: []string{WarningCodes.PodStaleRevision, WarningCodes.ScalingInProgress},
+	}}
+	if got := graph.Explanations(); !reflect.DeepEqual(got, want) {
+		t.Errorf("Explanations() = %+v, want %+v", got, want)
+	}
+}
+
+func TestExplanationsFreshCodes(t *testing.T) {
+	tests := []struct {
+		name     string
+		warnings []MappingWarning
+		want     [][]string // codes of each explanation, in order
+	}{
+		{
+			name: "refined single-code rules are skipped",
+			warnings: []MappingWarning{
+				warning(WarningLevelWarning, WarningCodes.DeletionInProgress),
+				warning(WarningLevelWarning, WarningCodes.OrphanedResource),
+			},
+			want: [][]string{
+				{WarningCodes.DeletionInProgress, WarningCodes.OrphanedResource},
+			},
+		},
+		{
+			name: "a down controller explains the missing workloads",
+			warnings: []MappingWarning{
+				warning(WarningLevelError, WarningCodes.ControllerUnavailable),
+				warning(WarningLevelError, WarningCodes.MasterMissing),
+				warning(WarningLevelError, WarningCodes.WorkerMissing),
+			},
+			want: [][]string{
+				{WarningCodes.ControllerUnavailable, WarningCodes.MasterMissing, WarningCodes.WorkerMissing},
+			},
+		},
+		{
+			name: "a rule with one unexplained code still matches",
+			warnings: []MappingWarning{
+				warning(WarningLevelWarning, WarningCodes.RuntimeNotBound),
+				warning(WarningLevelError, WarningCodes.WorkerMissing),
+				warning(WarningLevelError, WarningCodes.MasterMissing),
+			},
+			want: [][]string{
+				{WarningCodes.RuntimeNotBound, WarningCodes.WorkerMissing},
+				{WarningCodes.MasterMissing, WarningCodes.WorkerMissing},
+			},
+		},
+		{
+			name: "repeated warnings explained once",
+			warnings: []MappingWarning{
+				warning(WarningLevelWarning, WarningCodes.PodsNotReady),
+				warning(WarningLevelWarning, WarningCodes.PodsNotReady),
+			},
+			want: [][]string{
+				{WarningCodes.PodsNotReady},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got [][]string
+			for _, e := range (&ResourceGraph{Warnings: tt.warnings}).Explanations() {
+				got = append(got, e.Codes)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("explained codes = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestExplanationsUncoveredWarnings(t *testing.T) {
+	graph := &ResourceGraph{Warnings: []MappingWarning{
+		{Level: WarningLevelInfo, Code: WarningCodes.EventListFailed, Message: "Failed to list Events for pod demo-data-worker-0"},
+		{Level: WarningLevelInfo, Code: WarningCodes.EventListFailed, Message: "Failed to list Events for pod demo-data-worker-1"},
+	}}
+
+	want := []Explanation{{
+		Level: WarningLevelInfo,
+		Cause: "Failed to list Events for pod demo-data-worker-0",
+		Codes: []string{WarningCodes.EventListFailed},
+	}}
+	if got := graph.Explanations(); !reflect.DeepEqual(got, want) {
+		t.Errorf("Explanations() = %+v, want %+v", got, want)
+	}
+}
+
+func TestExplanationsOrderedBySeverity(t *testing.T) {
+	graph := &ResourceGraph{Warnings: []MappingWarning{
+		warning(WarningLevelInfo, WarningCodes.ScalingInProgress),
+		warning(WarningLevelWarning, WarningCodes.FuseMissing),
+		{Level: WarningLevelWarning, Code: "CUSTOM_CHECK", Message: "custom check failed"},
+		warning(WarningLevelError, WarningCodes.PVCMissing),
+		warning(WarningLevelWarning, WarningCodes.ServiceNoEndpoints),
+	}}
+
+	// Errors first; within a level, rules in table order, then uncovered warnings
+	want := []string{
+		WarningCodes.PVCMissing,
+		WarningCodes.ServiceNoEndpoints,
+		WarningCodes.FuseMissing,
+		"CUSTOM_CHECK",
+		WarningCodes.ScalingInProgress,
+	}
+	var got []string
+	for _, e := range graph.Explanations() {
+		got = append(got, e.Codes...)
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("explanation order = %v, want %v", got, want)
+	}
+}
+
+func TestExplanationsTakeHighestLevel(t *testing.T) {
+	graph := &ResourceGraph{Warnings: []MappingWarning{
+		warning(WarningLevelWarning, WarningCodes.ComponentNotReady),
+		warning(WarningLevelError, WarningCodes.ComponentNotReady),
+		warning(WarningLevelWarning, WarningCodes.PodsNotReady),
+	}}
+
+	explanations := graph.Explanations()
+	if len(explanations) != 1 || explanations[0].Level != WarningLevelError {
+		t.Errorf("Explanations() = %+v, want one error-level explanation", explanations)
+	}
+}
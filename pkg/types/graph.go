@@ -3,6 +3,8 @@
 package types
 
 import (
+	"fmt"
+	"sort"
 	"time"
 )
 
@@ -20,6 +22,17 @@ const (
 	RuntimeTypeUnknown  RuntimeType = "unknown"
 )
 
+// FuseMode is how a runtime delivers its fuse to application pods
+type FuseMode string
+
+const (
+	// FuseModeDaemonSet runs the fuse in a per-node DaemonSet, mounted into pods through CSI
+	FuseModeDaemonSet FuseMode = "DaemonSet"
+
+	// FuseModeSidecar runs the fuse as a container Fluid's webhook injects into each application pod
+	FuseModeSidecar FuseMode = "Sidecar"
+)
+
 // ComponentType represents the type of runtime component
 type ComponentType string
 
@@ -29,6 +42,15 @@ const (
 	ComponentFuse    ComponentType = "fuse"
 	ComponentStorage ComponentType = "storage"
 	ComponentConfig  ComponentType = "config"
+
+	// ComponentOperation marks data operation CRs (DataLoad, DataBackup, DataMigrate) targeting the Dataset
+	ComponentOperation ComponentType = "operation"
+
+	// ComponentController marks the Fluid runtime controller Deployment and its pods
+	ComponentController ComponentType = "controller"
+
+	// ComponentConsumer marks application pods mounting the Dataset's PVC
+	ComponentConsumer ComponentType = "consumer"
 )
 
 // WarningLevel represents the severity of a mapping warning
@@ -51,6 +73,13 @@ const (
 	PhaseUnknown  ResourcePhase = "Unknown"
 	PhaseBound    ResourcePhase = "Bound"
 	PhaseNotBound ResourcePhase = "NotBound"
+
+	// PhaseUpdating marks a StatefulSet rolling out a new revision
+	PhaseUpdating ResourcePhase = "Updating"
+
+	// Phases reported by data operations
+	PhaseExecuting ResourcePhase = "Executing"
+	PhaseComplete  ResourcePhase = "Complete"
 )
 
 // ResourceGraph is the main output structure containing the complete
@@ -59,9 +88,12 @@ type ResourceGraph struct {
 	// Dataset is the root Dataset CR
 	Dataset DatasetNode `json:"dataset"`
 
-	// Runtime is the bound Runtime CR (nil if not bound)
+	// Runtime is the first bound Runtime CR (nil if not bound), kept for backwards compatibility
 	Runtime *RuntimeNode `json:"runtime,omitempty"`
 
+	// Runtimes are all Runtime CRs bound to the Dataset
+	Runtimes []*RuntimeNode `json:"runtimes,omitempty"`
+
 	// Resources is the list of all discovered Kubernetes resources
 	Resources []K8sResourceNode `json:"resources"`
 
@@ -95,8 +127,67 @@ type DatasetNode struct {
 	// Conditions are the current conditions of the Dataset
 	Conditions []ConditionBrief `json:"conditions,omitempty"`
 
-	// MountPoints lists the configured mount points
+	// MountPoints lists the configured mount point URIs; see Mounts for details
 	MountPoints []string `json:"mountPoints,omitempty"`
+
+	// Mounts lists the configured mounts with their names, paths and options
+	Mounts []MountPoint `json:"mounts,omitempty"`
+
+	// AccessModes are the spec.accessModes the Dataset's PVC is created with;
+	// Fluid defaults to ReadOnlyMany when none are set
+	AccessModes []string `json:"accessModes,omitempty"`
+
+	// SharedOptions are the spec.sharedOptions applied to every mount
+	SharedOptions map[string]string `json:"sharedOptions,omitempty"`
+
+	// Runtimes are the runtime references listed in the Dataset status
+	Runtimes []RuntimeRef `json:"runtimes,omitempty"`
+
+	// Operations are the data operations the Dataset status reports as
+	// holding it (status.operationRef, or the older dataLoadRef/dataBackupRef)
+	Operations []DatasetOperation `json:"operations,omitempty"`
+
+	// Owner contains ownership information of the Dataset CR
+	Owner *OwnerInfo `json:"owner,omitempty"`
+
+	// RawSpec is the Dataset's unparsed spec, set only with the mapper's IncludeRawSpec option
+	RawSpec map[string]interface{} `json:"rawSpec,omitempty"`
+}
+
+// MountPoint is an entry of the Dataset's spec.mounts
+type MountPoint struct {
+	// MountPoint is the UFS URI (e.g., s3://bucket/path)
+	MountPoint string `json:"mountPoint"`
+
+	// Name of the mount
+	Name string `json:"name,omitempty"`
+
+	// Path where the mount appears in the runtime's namespace (defaults to /{name})
+	Path string `json:"path,omitempty"`
+
+	// Options are the mount options passed to the runtime
+	Options map[string]string `json:"options,omitempty"`
+}
+
+// RuntimeRef references a Runtime CR from the Dataset status
+type RuntimeRef struct {
+	// Name of the Runtime
+	Name string `json:"name"`
+
+	// Namespace of the Runtime
+	Namespace string `json:"namespace,omitempty"`
+
+	// Type is the runtime type (alluxio, jindo, juicefs, etc.)
+	Type RuntimeType `json:"type"`
+}
+
+// DatasetOperation is a data operation referenced from the Dataset status
+type DatasetOperation struct {
+	// Type is the operation kind (DataLoad, DataBackup, DataMigrate, etc.)
+	Type string `json:"type"`
+
+	// Name of the operation, as recorded by the Dataset status
+	Name string `json:"name"`
 }
 
 // RuntimeNode represents a Runtime Custom Resource (AlluxioRuntime, JindoRuntime, etc.)
@@ -128,8 +219,86 @@ type RuntimeNode struct {
 	// FuseReady shows ready/desired fuse instances (e.g., "5/5")
 	FuseReady string `json:"fuseReady,omitempty"`
 
+	// FuseMode is whether the fuse runs as a DaemonSet or as sidecars in
+	// application pods; empty when neither was found
+	FuseMode FuseMode `json:"fuseMode,omitempty"`
+
+	// FuseSidecars are the application pods running an injected fuse sidecar
+	// for the runtime, set in sidecar mode
+	FuseSidecars []string `json:"fuseSidecars,omitempty"`
+
+	// WorkerReplicas is the worker count the Runtime spec asks for
+	// (spec.worker.replicas, or spec.replicas on runtimes that use it)
+	WorkerReplicas int64 `json:"workerReplicas,omitempty"`
+
 	// Conditions are the current conditions of the Runtime
 	Conditions []ConditionBrief `json:"conditions,omitempty"`
+
+	// Kind of the Runtime CR (e.g., AlluxioRuntime)
+	Kind string `json:"kind,omitempty"`
+
+	// Owner contains ownership information of the Runtime CR
+	Owner *OwnerInfo `json:"owner,omitempty"`
+
+	// Cache aggregates the cache capacity and usage across the workers
+	Cache *CacheSummary `json:"cache,omitempty"`
+
+	// Profile is the ThinRuntimeProfile a ThinRuntime references
+	Profile *ThinRuntimeProfile `json:"profile,omitempty"`
+
+	// RawSpec is the Runtime's unparsed spec, set only with the mapper's IncludeRawSpec option
+	RawSpec map[string]interface{} `json:"rawSpec,omitempty"`
+}
+
+// ThinRuntimeProfile is the profile behind a ThinRuntime, which defines the
+// fuse that mounts the storage
+type ThinRuntimeProfile struct {
+	// Name of the ThinRuntimeProfile (spec.profileName of the ThinRuntime)
+	Name string `json:"name"`
+
+	// FileSystemType is the filesystem the fuse mounts (e.g., "s3fs", "nfs")
+	FileSystemType string `json:"fileSystemType,omitempty"`
+
+	// FuseImage is the fuse image and tag; the ThinRuntime's own spec.fuse
+	// image takes precedence over the profile's
+	FuseImage string `json:"fuseImage,omitempty"`
+
+	// Found is false when the referenced profile does not exist
+	Found bool `json:"found"`
+}
+
+// CacheSummary aggregates a Runtime's cache across its workers, from the
+// tiered store in its spec and the cache states in its status
+type CacheSummary struct {
+	// Capacity is the total cache capacity of all workers (e.g., "24Gi")
+	Capacity string `json:"capacity,omitempty"`
+
+	// Used is the amount of data currently cached
+	Used string `json:"used,omitempty"`
+
+	// Percentage is Used as a percentage of Capacity (e.g., "52.1%")
+	Percentage string `json:"percentage,omitempty"`
+
+	// Workers is the number of workers the capacity is spread over
+	Workers int64 `json:"workers,omitempty"`
+
+	// Tiers breaks the capacity down by tiered store level
+	Tiers []CacheTier `json:"tiers,omitempty"`
+}
+
+// CacheTier is one level of a Runtime's tiered store
+type CacheTier struct {
+	// Medium is the storage medium (MEM, SSD, HDD)
+	Medium string `json:"medium"`
+
+	// Path is where the tier is mounted on each worker
+	Path string `json:"path,omitempty"`
+
+	// Quota is the tier's capacity on each worker
+	Quota string `json:"quota,omitempty"`
+
+	// Capacity is the tier's capacity over all workers
+	Capacity string `json:"capacity,omitempty"`
 }
 
 // K8sResourceNode represents a discovered Kubernetes resource
@@ -149,9 +318,22 @@ type K8sResourceNode struct {
 	// Component indicates which Fluid component this resource belongs to
 	Component ComponentType `json:"component"`
 
+	// Runtime is the name of the Runtime this resource was discovered for
+	Runtime string `json:"runtime,omitempty"`
+
+	// Node is the name of the node a pod is scheduled on
+	Node string `json:"node,omitempty"`
+
 	// Status contains the health status of the resource
 	Status ResourceStatus `json:"status"`
 
+	// Terminating is true once deletion of the resource was requested; a resource
+	// that stays terminating is usually blocked by a finalizer
+	Terminating bool `json:"terminating,omitempty"`
+
+	// DeletionTimestamp is set (RFC3339) once deletion of the resource was requested
+	DeletionTimestamp string `json:"deletionTimestamp,omitempty"`
+
 	// Owner contains ownership information
 	Owner *OwnerInfo `json:"owner,omitempty"`
 
@@ -161,6 +343,10 @@ type K8sResourceNode struct {
 	// Details contains additional resource-specific information
 	Details map[string]string `json:"details,omitempty"`
 
+	// Events are the most recent Events of a non-Ready pod, oldest first,
+	// formatted as "Reason: Message"
+	Events []string `json:"events,omitempty"`
+
 	// Children are resources owned by this resource (e.g., Pods owned by StatefulSet)
 	Children []K8sResourceNode `json:"children,omitempty"`
 }
@@ -176,8 +362,11 @@ type ResourceStatus struct {
 	// Message provides additional context about the status
 	Message string `json:"message,omitempty"`
 
-	// Age is the age of the resource
+	// Age is the age of the resource in human-readable form, e.g. "3h"
 	Age string `json:"age,omitempty"`
+
+	// CreatedAt is the resource's creation timestamp, for exact ages and sorting
+	CreatedAt time.Time `json:"createdAt"`
 }
 
 // OwnerInfo contains information about the resource's owner
@@ -190,6 +379,21 @@ type OwnerInfo struct {
 
 	// UID of the owner resource
 	UID string `json:"uid,omitempty"`
+
+	// Parent is the owner's own owner, set when the owner chain is resolved
+	Parent *OwnerInfo `json:"parent,omitempty"`
+}
+
+// String formats the owner chain, e.g. "StatefulSet/demo-worker → AlluxioRuntime/demo"
+func (o *OwnerInfo) String() string {
+	if o == nil {
+		return ""
+	}
+	chain := o.Kind + "/" + o.Name
+	if o.Parent != nil {
+		chain += " → " + o.Parent.String()
+	}
+	return chain
 }
 
 // ConditionBrief is a simplified view of a Kubernetes condition
@@ -210,6 +414,26 @@ type ConditionBrief struct {
 	LastTransitionTime string `json:"lastTransitionTime,omitempty"`
 }
 
+// Age returns how long before now the condition last transitioned. ok is
+// false when LastTransitionTime is unset or not an RFC 3339 timestamp.
+func (c ConditionBrief) Age(now time.Time) (age time.Duration, ok bool) {
+	if c.LastTransitionTime == "" {
+		return 0, false
+	}
+	transitioned, err := time.Parse(time.RFC3339, c.LastTransitionTime)
+	if err != nil {
+		return 0, false
+	}
+	return now.Sub(transitioned), true
+}
+
+// TransitionedWithin reports whether the condition changed in the window
+// before now. Conditions without a transition time never match.
+func (c ConditionBrief) TransitionedWithin(window time.Duration, now time.Time) bool {
+	age, ok := c.Age(now)
+	return ok && age <= window
+}
+
 // MappingWarning represents a detected issue during the mapping process
 type MappingWarning struct {
 	// Level indicates severity (error, warning, info)
@@ -244,50 +468,100 @@ type GraphMetadata struct {
 
 	// MockMode indicates if mock data was used
 	MockMode bool `json:"mockMode,omitempty"`
+
+	// LabelSelector is the selector that matched the runtime resources
+	LabelSelector string `json:"labelSelector,omitempty"`
 }
 
-// WarningCodes defines standard warning codes for the mapper
+// WarningCodes defines standard warning codes for the mapper. Each code
+// also needs a template in the WarningCatalog.
 var WarningCodes = struct {
-	DatasetNotFound    string
-	RuntimeNotBound    string
-	RuntimeNotFound    string
-	MasterMissing      string
-	WorkerMissing      string
-	FuseMissing        string
-	PodsNotReady       string
-	PVCMissing         string
-	PVNotBound         string
-	ConfigMapMissing   string
-	OrphanedResource   string
-	UnknownRuntimeType string
-	PartialCreation    string
-	ScalingInProgress  string
-	DeletionInProgress string
+	DatasetNotFound         string
+	RuntimeNotBound         string
+	RuntimeNotFound         string
+	MasterMissing           string
+	WorkerMissing           string
+	FuseMissing             string
+	PodsNotReady            string
+	PVCMissing              string
+	PVNotBound              string
+	ConfigMapMissing        string
+	OrphanedResource        string
+	UnknownRuntimeType      string
+	PartialCreation         string
+	ScalingInProgress       string
+	DeletionInProgress      string
+	ComponentNotReady       string
+	PodStaleRevision        string
+	MappingIncomplete       string
+	DataMigrateRunning      string
+	ServiceNoEndpoints      string
+	RuntimeTypeProbed       string
+	DataOperationInProgress string
+	UFSUnreachable          string
+	PVMismatch              string
+	StorageClassMissing     string
+	ThinProfileMissing      string
+	ReplicaDrift            string
+	RuntimeRefMismatch      string
+	WaitingForConsumer      string
+	ControllerUnavailable   string
+	ReleaseLabelMissing     string
+	InvalidLabelSelector    string
+	EventListFailed         string
+	ServiceListFailed       string
+	EndpointsGetFailed      string
+	PodListFailed           string
+	StorageClassGetFailed   string
 }{
-	DatasetNotFound:    "DATASET_NOT_FOUND",
-	RuntimeNotBound:    "RUNTIME_NOT_BOUND",
-	RuntimeNotFound:    "RUNTIME_NOT_FOUND",
-	MasterMissing:      "MASTER_MISSING",
-	WorkerMissing:      "WORKER_MISSING",
-	FuseMissing:        "FUSE_MISSING",
-	PodsNotReady:       "PODS_NOT_READY",
-	PVCMissing:         "PVC_MISSING",
-	PVNotBound:         "PV_NOT_BOUND",
-	ConfigMapMissing:   "CONFIGMAP_MISSING",
-	OrphanedResource:   "ORPHANED_RESOURCE",
-	UnknownRuntimeType: "UNKNOWN_RUNTIME_TYPE",
-	PartialCreation:    "PARTIAL_CREATION",
-	ScalingInProgress:  "SCALING_IN_PROGRESS",
-	DeletionInProgress: "DELETION_IN_PROGRESS",
+	DatasetNotFound:         "DATASET_NOT_FOUND",
+	RuntimeNotBound:         "RUNTIME_NOT_BOUND",
+	RuntimeNotFound:         "RUNTIME_NOT_FOUND",
+	MasterMissing:           "MASTER_MISSING",
+	WorkerMissing:           "WORKER_MISSING",
+	FuseMissing:             "FUSE_MISSING",
+	PodsNotReady:            "PODS_NOT_READY",
+	PVCMissing:              "PVC_MISSING",
+	PVNotBound:              "PV_NOT_BOUND",
+	ConfigMapMissing:        "CONFIGMAP_MISSING",
+	OrphanedResource:        "ORPHANED_RESOURCE",
+	UnknownRuntimeType:      "UNKNOWN_RUNTIME_TYPE",
+	PartialCreation:         "PARTIAL_CREATION",
+	ScalingInProgress:       "SCALING_IN_PROGRESS",
+	DeletionInProgress:      "DELETION_IN_PROGRESS",
+	ComponentNotReady:       "COMPONENT_NOT_READY",
+	PodStaleRevision:        "POD_STALE_REVISION",
+	MappingIncomplete:       "MAPPING_INCOMPLETE",
+	DataMigrateRunning:      "DATA_MIGRATE_RUNNING",
+	ServiceNoEndpoints:      "SERVICE_NO_ENDPOINTS",
+	RuntimeTypeProbed:       "RUNTIME_TYPE_PROBED",
+	DataOperationInProgress: "DATA_OPERATION_IN_PROGRESS",
+	UFSUnreachable:          "UFS_UNREACHABLE",
+	PVMismatch:              "PV_MISMATCH",
+	StorageClassMissing:     "STORAGE_CLASS_MISSING",
+	ThinProfileMissing:      "THIN_PROFILE_MISSING",
+	ReplicaDrift:            "REPLICA_DRIFT",
+	RuntimeRefMismatch:      "RUNTIME_REF_MISMATCH",
+	WaitingForConsumer:      "WAITING_FOR_FIRST_CONSUMER",
+	ControllerUnavailable:   "CONTROLLER_UNAVAILABLE",
+	ReleaseLabelMissing:     "RELEASE_LABEL_MISSING",
+	InvalidLabelSelector:    "INVALID_LABEL_SELECTOR",
+	EventListFailed:         "EVENT_LIST_FAILED",
+	ServiceListFailed:       "SVC_LIST_FAILED",
+	EndpointsGetFailed:      "ENDPOINTS_GET_FAILED",
+	PodListFailed:           "POD_LIST_FAILED",
+	StorageClassGetFailed:   "STORAGECLASS_GET_FAILED",
 }
 
 // StatusIcon returns a visual indicator for the given phase
 func (p ResourcePhase) StatusIcon() string {
 	switch p {
-	case PhaseReady, PhaseBound:
+	case PhaseReady, PhaseBound, PhaseComplete:
 		return "✓"
 	case PhaseNotReady, PhasePending:
 		return "⚠"
+	case PhaseExecuting, PhaseUpdating:
+		return "⟳"
 	case PhaseFailed, PhaseNotBound:
 		return "✗"
 	default:
@@ -309,6 +583,45 @@ func (w WarningLevel) StatusIcon() string {
 	}
 }
 
+// Severity ranks warning levels: error > warning > info > unknown
+func (w WarningLevel) Severity() int {
+	switch w {
+	case WarningLevelError:
+		return 3
+	case WarningLevelWarning:
+		return 2
+	case WarningLevelInfo:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// WarningsAtLeast returns the warnings at or above the given level, most
+// severe first. Warnings of equal severity keep their detection order.
+func (g *ResourceGraph) WarningsAtLeast(level WarningLevel) []MappingWarning {
+	var warnings []MappingWarning
+	for _, w := range g.Warnings {
+		if w.Level.Severity() >= level.Severity() {
+			warnings = append(warnings, w)
+		}
+	}
+	sort.SliceStable(warnings, func(i, j int) bool {
+		return warnings[i].Level.Severity() > warnings[j].Level.Severity()
+	})
+	return warnings
+}
+
+// Age returns how long ago the graph was mapped, or zero when MappedAt is
+// unset. Graphs loaded from files or caches describe the cluster as it was
+// then, not as it is now.
+func (g *ResourceGraph) Age() time.Duration {
+	if g.Metadata.MappedAt.IsZero() {
+		return 0
+	}
+	return time.Since(g.Metadata.MappedAt)
+}
+
 // IsHealthy returns true if the resource graph represents a healthy state
 func (g *ResourceGraph) IsHealthy() bool {
 	for _, w := range g.Warnings {
@@ -324,6 +637,96 @@ func (g *ResourceGraph) HasWarnings() bool {
 	return len(g.Warnings) > 0
 }
 
+// Health score penalties applied by HealthScore
+const (
+	healthPenaltyError   = 25
+	healthPenaltyWarning = 10
+	healthPenaltyInfo    = 2
+	healthPenaltyReady   = 30
+)
+
+// HealthScore returns a 0-100 score and an A-F letter grade for the graph.
+// Error-level warnings weigh heavily, warning-level ones moderately, and the
+// average ready ratio of workloads (e.g. "2/3") scales a further penalty.
+func (g *ResourceGraph) HealthScore() (int, string) {
+	score := 100
+	for _, w := range g.Warnings {
+		switch w.Level {
+		case WarningLevelError:
+			score -= healthPenaltyError
+		case WarningLevelWarning:
+			score -= healthPenaltyWarning
+		case WarningLevelInfo:
+			score -= healthPenaltyInfo
+		}
+	}
+
+	var ratioSum float64
+	var ratioCount int
+	for _, r := range g.Resources {
+		ready, total, ok := ParseReady(r.Status.Ready)
+		if !ok || total == 0 {
+			continue
+		}
+		ratioSum += float64(ready) / float64(total)
+		ratioCount++
+	}
+	if ratioCount > 0 {
+		score -= int((1 - ratioSum/float64(ratioCount)) * healthPenaltyReady)
+	}
+
+	if score < 0 {
+		score = 0
+	}
+	return score, healthGrade(score)
+}
+
+// Readiness counts the graph's top-level resources and how many of them are
+// ready. A resource with a ready/desired count (e.g. "2/3") is ready when every
+// desired replica is; one without is ready when its phase is Ready, Bound or
+// Complete.
+func (g *ResourceGraph) Readiness() (ready, total int) {
+	for _, r := range g.Resources {
+		total++
+		if current, desired, ok := ParseReady(r.Status.Ready); ok {
+			if current >= desired {
+				ready++
+			}
+			continue
+		}
+		switch r.Status.Phase {
+		case PhaseReady, PhaseBound, PhaseComplete:
+			ready++
+		}
+	}
+	return ready, total
+}
+
+// healthGrade converts a health score to a letter grade
+func healthGrade(score int) string {
+	switch {
+	case score >= 90:
+		return "A"
+	case score >= 80:
+		return "B"
+	case score >= 70:
+		return "C"
+	case score >= 60:
+		return "D"
+	default:
+		return "F"
+	}
+}
+
+// ParseReady parses a ready/desired string such as "2/3"
+func ParseReady(ready string) (int, int, bool) {
+	var current, desired int
+	if _, err := fmt.Sscanf(ready, "%d/%d", &current, &desired); err != nil {
+		return 0, 0, false
+	}
+	return current, desired, true
+}
+
 // GetResourcesByKind returns all resources of a specific kind
 func (g *ResourceGraph) GetResourcesByKind(kind string) []K8sResourceNode {
 	var result []K8sResourceNode
@@ -346,6 +749,17 @@ func (g *ResourceGraph) GetResourcesByComponent(component ComponentType) []K8sRe
 	return result
 }
 
+// GetResourcesByRuntime returns all resources discovered for the named runtime
+func (g *ResourceGraph) GetResourcesByRuntime(name string) []K8sResourceNode {
+	var result []K8sResourceNode
+	for _, r := range g.Resources {
+		if r.Runtime == name {
+			result = append(result, r)
+		}
+	}
+	return result
+}
+
 // Summary returns a brief summary of the resource graph
 func (g *ResourceGraph) Summary() string {
 	if g.Runtime == nil {
@@ -0,0 +1,25 @@
+// Package types quick health verdicts built from counts
+package types
+
+// QuickHealth is a cheap readiness verdict for a Dataset, built from the
+// Dataset and Runtime status and from object counts instead of a full mapping
+type QuickHealth struct {
+	// Dataset is the name of the Dataset
+	Dataset string `json:"dataset"`
+
+	// Namespace of the Dataset
+	Namespace string `json:"namespace"`
+
+	// Phase is the Dataset phase (e.g., Bound)
+	Phase string `json:"phase"`
+
+	// Healthy is true when no reason below was found
+	Healthy bool `json:"healthy"`
+
+	// Counts is the number of objects of each counted kind (StatefulSet,
+	// DaemonSet, Pod) across the Dataset's runtimes
+	Counts map[string]int `json:"counts,omitempty"`
+
+	// Reasons explain why the Dataset is not healthy, one per problem
+	Reasons []string `json:"reasons,omitempty"`
+}
@@ -0,0 +1,142 @@
+// Package types JSON Schema export
+package types
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"time"
+)
+
+// SchemaID is the $id of the JSON Schema returned by Schema
+const SchemaID = "https://github.com/fluid-cloudnative/fluid-resource-mapper/schema/resource-graph.json"
+
+// Schema returns a JSON Schema (draft 2020-12) describing ResourceGraph and its
+// nested types. It is generated from the json struct tags: fields without
+// omitempty are required, and every named struct type is a $defs entry.
+func Schema() ([]byte, error) {
+	g := &schemaGenerator{defs: make(map[string]interface{})}
+	root := g.schemaFor(reflect.TypeOf(ResourceGraph{}))
+
+	doc := map[string]interface{}{
+		"$schema": "https://json-schema.org/draft/2020-12/schema",
+		"$id":     SchemaID,
+		"title":   "ResourceGraph",
+		"$ref":    root["$ref"],
+		"$defs":   g.defs,
+	}
+	return json.MarshalIndent(doc, "", "  ")
+}
+
+// schemaGenerator collects the definitions of named struct types
+type schemaGenerator struct {
+	defs map[string]interface{}
+}
+
+var timeType = reflect.TypeOf(time.Time{})
+
+// schemaFor returns the schema of a Go type, registering struct definitions
+func (g *schemaGenerator) schemaFor(t reflect.Type) map[string]interface{} {
+	if t == timeType {
+		return map[string]interface{}{"type": "string", "format": "date-time"}
+	}
+
+	switch t.Kind() {
+	case reflect.Ptr:
+		return g.schemaFor(t.Elem())
+	case reflect.String:
+		return map[string]interface{}{"type": "string"}
+	case reflect.Bool:
+		return map[string]interface{}{"type": "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return map[string]interface{}{"type": "integer"}
+	case reflect.Float32, reflect.Float64:
+		return map[string]interface{}{"type": "number"}
+	case reflect.Slice, reflect.Array:
+		return map[string]interface{}{"type": "array", "items": g.schemaFor(t.Elem())}
+	case reflect.Map:
+		return map[string]interface{}{"type": "object", "additionalProperties": g.schemaFor(t.Elem())}
+	case reflect.Struct:
+		return g.structRef(t)
+	default:
+		return map[string]interface{}{}
+	}
+}
+
+// structRef registers a struct definition once and returns a $ref to it.
+// Registering before walking the fields lets recursive types (Children) resolve.
+func (g *schemaGenerator) structRef(t reflect.Type) map[string]interface{} {
+	ref := map[string]interface{}{"$ref": "#/$defs/" + t.Name()}
+	if _, ok := g.defs[t.Name()]; ok {
+		return ref
+	}
+	g.defs[t.Name()] = nil
+
+	properties := make(map[string]interface{})
+	var required []string
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		name, omitempty, skip := jsonField(field)
+		if skip {
+			continue
+		}
+		prop := g.schemaFor(field.Type)
+		switch field.Type.Kind() {
+		case reflect.Ptr, reflect.Slice, reflect.Map:
+			// nil pointers, slices and maps marshal as null
+			prop = nullable(prop)
+		}
+		properties[name] = prop
+		if !omitempty {
+			required = append(required, name)
+		}
+	}
+
+	def := map[string]interface{}{
+		"type":       "object",
+		"properties": properties,
+	}
+	if len(required) > 0 {
+		def["required"] = required
+	}
+	g.defs[t.Name()] = def
+	return ref
+}
+
+// nullable extends a schema to also accept null
+func nullable(schema map[string]interface{}) map[string]interface{} {
+	if t, ok := schema["type"].(string); ok {
+		widened := make(map[string]interface{}, len(schema))
+		for k, v := range schema {
+			widened[k] = v
+		}
+		widened["type"] = []string{t, "null"}
+		return widened
+	}
+	return map[string]interface{}{
+		"anyOf": []interface{}{schema, map[string]interface{}{"type": "null"}},
+	}
+}
+
+// jsonField returns the JSON name of a struct field and whether it is omitempty
+func jsonField(field reflect.StructField) (name string, omitempty, skip bool) {
+	tag := field.Tag.Get("json")
+	if tag == "-" {
+		return "", false, true
+	}
+	parts := strings.Split(tag, ",")
+	name = parts[0]
+	if name == "" {
+		name = field.Name
+	}
+	for _, opt := range parts[1:] {
+		if opt == "omitempty" {
+			omitempty = true
+		}
+	}
+	return name, omitempty, false
+}
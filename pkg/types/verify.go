@@ -0,0 +1,70 @@
+// Package types naming-convention verification results
+package types
+
+// VerifyStatus is the outcome of checking one expected resource
+type VerifyStatus string
+
+const (
+	// VerifyFound means the resource exists under its conventional name with the expected labels
+	VerifyFound VerifyStatus = "FOUND"
+
+	// VerifyMissing means no resource exists under the conventional name
+	VerifyMissing VerifyStatus = "MISSING"
+
+	// VerifyMislabeled means the resource exists but label-based discovery would not find it
+	VerifyMislabeled VerifyStatus = "MISLABELED"
+)
+
+// VerifyCheck is one resource a Dataset's runtime is expected to have,
+// predicted from the naming conventions
+type VerifyCheck struct {
+	// Kind of the expected resource (e.g., StatefulSet)
+	Kind string `json:"kind"`
+
+	// Name the resource is expected under
+	Name string `json:"name"`
+
+	// Namespace the resource is expected in
+	Namespace string `json:"namespace"`
+
+	// Component the resource belongs to
+	Component ComponentType `json:"component"`
+
+	// Runtime is the name of the runtime expected to own the resource
+	Runtime string `json:"runtime,omitempty"`
+
+	// Status is FOUND, MISSING or MISLABELED
+	Status VerifyStatus `json:"status"`
+
+	// Optional is true for resources not every runtime version creates
+	// (e.g., per-component ConfigMaps); they do not fail the verification
+	Optional bool `json:"optional,omitempty"`
+
+	// Message explains a MISLABELED status
+	Message string `json:"message,omitempty"`
+}
+
+// VerifyReport lists the expected resources of a Dataset and whether each exists
+type VerifyReport struct {
+	// Dataset is the name of the Dataset
+	Dataset string `json:"dataset"`
+
+	// Namespace of the Dataset
+	Namespace string `json:"namespace"`
+
+	// Phase is the Dataset phase (e.g., Bound)
+	Phase string `json:"phase"`
+
+	// Checks are the expected resources, in runtime and component order
+	Checks []VerifyCheck `json:"checks"`
+}
+
+// Passed reports whether every required resource was found with the expected labels
+func (r *VerifyReport) Passed() bool {
+	for _, c := range r.Checks {
+		if c.Status != VerifyFound && !c.Optional {
+			return false
+		}
+	}
+	return true
+}